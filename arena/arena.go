@@ -0,0 +1,191 @@
+// Package arena plays built-in policies against each other and rates them.
+package arena
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+
+	"scout-go/server"
+)
+
+type Config struct {
+	Policies []string // one entrant per seat
+	Games    int      // games played in each seating arrangement
+	Seed     int64    // game i of every arrangement is dealt from Seed+i
+}
+
+type Result struct {
+	Games    int             `json:"games"`
+	Aborted  int             `json:"aborted"`
+	Entrants []EntrantResult `json:"entrants"`
+}
+
+type EntrantResult struct {
+	Name       string  `json:"name"`
+	Policy     string  `json:"policy"`
+	Games      int     `json:"games"`
+	Wins       float64 `json:"wins"` // ties for first are shared
+	WinRate    float64 `json:"win_rate"`
+	MeanScore  float64 `json:"mean_score"`
+	ScoreCI95  float64 `json:"score_ci95"` // half-width of the 95% confidence interval
+	Rating     float64 `json:"rating"`     // Elo scale, mean 1500
+	RatingCI95 float64 `json:"rating_ci95"`
+}
+
+// Run plays cfg.Games games in every seating arrangement of the entrants
+func Run(cfg Config) (*Result, error) {
+	n := len(cfg.Policies)
+	if n < 2 || n > 5 {
+		return nil, fmt.Errorf("arena needs between 2 and 5 policies, got %d", n)
+	}
+	if cfg.Games < 1 {
+		return nil, fmt.Errorf("games must be positive")
+	}
+
+	policies := make([]server.Policy, n)
+	names := entrantNames(cfg.Policies)
+	for i, name := range cfg.Policies {
+		p, err := server.NewPolicy(name)
+		if err != nil {
+			return nil, err
+		}
+		policies[i] = p
+	}
+
+	scores := make([][]float64, n) // per entrant, one score per game
+	wins := make([]float64, n)
+	pairs := newPairwise(n)
+	aborted := 0
+	played := 0
+
+	for a, seating := range permutations(n) {
+		for i := 0; i < cfg.Games; i++ {
+			seed := cfg.Seed + int64(i)
+			seated := make([]server.Policy, n)
+			for seat, entrant := range seating {
+				seated[seat] = policies[entrant]
+			}
+			final, ok := playGame(seated, seed, rand.New(rand.NewPCG(uint64(seed), uint64(a))))
+			if !ok {
+				aborted++
+				continue
+			}
+			played++
+
+			// map seat scores back to entrants
+			entrantScores := make([]float64, n)
+			for seat, entrant := range seating {
+				entrantScores[entrant] = float64(final[seat])
+				scores[entrant] = append(scores[entrant], float64(final[seat]))
+			}
+			best := math.Inf(-1)
+			for _, s := range entrantScores {
+				best = math.Max(best, s)
+			}
+			winners := 0
+			for _, s := range entrantScores {
+				if s == best {
+					winners++
+				}
+			}
+			for e, s := range entrantScores {
+				if s == best {
+					wins[e] += 1 / float64(winners)
+				}
+			}
+			pairs.add(entrantScores)
+		}
+	}
+
+	ratings, ratingErrs := pairs.fit()
+	result := &Result{Games: played, Aborted: aborted}
+	for e := 0; e < n; e++ {
+		mean, ci := meanCI(scores[e])
+		entrant := EntrantResult{
+			Name:       names[e],
+			Policy:     cfg.Policies[e],
+			Games:      len(scores[e]),
+			Wins:       wins[e],
+			MeanScore:  mean,
+			ScoreCI95:  ci,
+			Rating:     ratings[e],
+			RatingCI95: ratingErrs[e],
+		}
+		if entrant.Games > 0 {
+			entrant.WinRate = wins[e] / float64(entrant.Games)
+		}
+		result.Entrants = append(result.Entrants, entrant)
+	}
+	return result, nil
+}
+
+// playGame plays one game to completion and returns the final score of each seat
+func playGame(seated []server.Policy, seed int64, r *rand.Rand) ([]int, bool) {
+	game, err := server.NewSeededGame(len(seated), seed)
+	if err != nil || !server.PlayOut(game, seated, r, nil) {
+		return nil, false
+	}
+	final := make([]int, len(seated))
+	for i, p := range game.Players {
+		final[i] = p.Score
+	}
+	return final, true
+}
+
+// entrantNames labels each entrant by policy, numbering repeated policies
+func entrantNames(policies []string) []string {
+	count := make(map[string]int)
+	for _, p := range policies {
+		count[p]++
+	}
+	seen := make(map[string]int)
+	names := make([]string, len(policies))
+	for i, p := range policies {
+		names[i] = p
+		if count[p] > 1 {
+			seen[p]++
+			names[i] = fmt.Sprintf("%s#%d", p, seen[p])
+		}
+	}
+	return names
+}
+
+// permutations returns every ordering of 0..n-1; element i of an ordering is the
+// entrant in seat i
+func permutations(n int) [][]int {
+	if n == 1 {
+		return [][]int{{0}}
+	}
+	perms := make([][]int, 0)
+	for _, p := range permutations(n - 1) {
+		for pos := 0; pos <= len(p); pos++ {
+			perm := make([]int, 0, n)
+			perm = append(perm, p[:pos]...)
+			perm = append(perm, n-1)
+			perm = append(perm, p[pos:]...)
+			perms = append(perms, perm)
+		}
+	}
+	return perms
+}
+
+func meanCI(xs []float64) (float64, float64) {
+	if len(xs) == 0 {
+		return 0, 0
+	}
+	var sum float64
+	for _, x := range xs {
+		sum += x
+	}
+	mean := sum / float64(len(xs))
+	if len(xs) < 2 {
+		return mean, 0
+	}
+	var ss float64
+	for _, x := range xs {
+		ss += (x - mean) * (x - mean)
+	}
+	sd := math.Sqrt(ss / float64(len(xs)-1))
+	return mean, 1.96 * sd / math.Sqrt(float64(len(xs)))
+}
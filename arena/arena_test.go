@@ -0,0 +1,62 @@
+package arena
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestPermutations(t *testing.T) {
+	for n, expected := range map[int]int{2: 2, 3: 6, 5: 120} {
+		perms := permutations(n)
+		if len(perms) != expected {
+			t.Fatalf("expected %d permutations of %d, got %d", expected, n, len(perms))
+		}
+		seen := make(map[string]bool)
+		for _, p := range perms {
+			key := fmt.Sprint(p)
+			if seen[key] {
+				t.Fatalf("duplicate permutation %v", p)
+			}
+			seen[key] = true
+		}
+	}
+}
+
+func TestRunIsReproducible(t *testing.T) {
+	cfg := Config{Policies: []string{"greedy", "random"}, Games: 3, Seed: 42}
+	first, err := Run(cfg)
+	if err != nil {
+		t.Fatalf("Run returned err: %v", err)
+	}
+	second, err := Run(cfg)
+	if err != nil {
+		t.Fatalf("Run returned err: %v", err)
+	}
+	if first.Games+first.Aborted != 6 {
+		t.Fatalf("expected 6 games, got %d", first.Games+first.Aborted)
+	}
+	for i := range first.Entrants {
+		if first.Entrants[i] != second.Entrants[i] {
+			t.Fatalf("entrant %d differs between runs: %v, %v", i, first.Entrants[i], second.Entrants[i])
+		}
+	}
+}
+
+func TestFitRanksStrongerEntrantHigher(t *testing.T) {
+	pairs := newPairwise(2)
+	for i := 0; i < 10; i++ {
+		pairs.add([]float64{5, -3})
+	}
+	pairs.add([]float64{-1, 2})
+	ratings, errs := pairs.fit()
+	if ratings[0] <= ratings[1] {
+		t.Fatalf("expected entrant 0 to be rated higher, got %v", ratings)
+	}
+	if math.Abs(ratings[0]+ratings[1]-2*RATING_MEAN) > 1e-6 {
+		t.Fatalf("expected ratings to average %v, got %v", RATING_MEAN, ratings)
+	}
+	if errs[0] <= 0 {
+		t.Fatalf("expected a positive rating interval, got %v", errs[0])
+	}
+}
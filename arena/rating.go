@@ -0,0 +1,108 @@
+package arena
+
+import "math"
+
+const (
+	RATING_MEAN       = 1500.0
+	RATING_ITERATIONS = 1000
+)
+
+// pairwise records head-to-head results between entrants. each game counts as one
+// match between every pair of entrants: the higher score wins, equal scores draw.
+type pairwise struct {
+	n     int
+	wins  [][]float64 // wins[i][j] is i's score against j
+	games [][]float64 // games[i][j] is the number of matches between i and j
+}
+
+func newPairwise(n int) *pairwise {
+	p := &pairwise{n: n, wins: make([][]float64, n), games: make([][]float64, n)}
+	for i := 0; i < n; i++ {
+		p.wins[i] = make([]float64, n)
+		p.games[i] = make([]float64, n)
+	}
+	return p
+}
+
+func (p *pairwise) add(scores []float64) {
+	for i := 0; i < p.n; i++ {
+		for j := i + 1; j < p.n; j++ {
+			p.games[i][j]++
+			p.games[j][i]++
+			switch {
+			case scores[i] > scores[j]:
+				p.wins[i][j]++
+			case scores[i] < scores[j]:
+				p.wins[j][i]++
+			default:
+				p.wins[i][j] += 0.5
+				p.wins[j][i] += 0.5
+			}
+		}
+	}
+}
+
+// fit fits a Bradley-Terry model to the results and returns each entrant's rating on
+// the Elo scale, with the half-width of its 95% confidence interval. every pair gets
+// one virtual draw so that unbeaten or winless entrants still have finite ratings.
+func (p *pairwise) fit() ([]float64, []float64) {
+	wins := make([][]float64, p.n)
+	games := make([][]float64, p.n)
+	for i := 0; i < p.n; i++ {
+		wins[i] = make([]float64, p.n)
+		games[i] = make([]float64, p.n)
+		for j := 0; j < p.n; j++ {
+			if i != j {
+				wins[i][j] = p.wins[i][j] + 0.5
+				games[i][j] = p.games[i][j] + 1
+			}
+		}
+	}
+
+	// minorization-maximization updates (Hunter, 2004)
+	gamma := make([]float64, p.n)
+	for i := range gamma {
+		gamma[i] = 1
+	}
+	for iter := 0; iter < RATING_ITERATIONS; iter++ {
+		next := make([]float64, p.n)
+		for i := 0; i < p.n; i++ {
+			var w, d float64
+			for j := 0; j < p.n; j++ {
+				if i == j {
+					continue
+				}
+				w += wins[i][j]
+				d += games[i][j] / (gamma[i] + gamma[j])
+			}
+			next[i] = w / d
+		}
+		// keep the geometric mean at 1
+		var logSum float64
+		for _, g := range next {
+			logSum += math.Log(g)
+		}
+		norm := math.Exp(logSum / float64(p.n))
+		for i := range next {
+			next[i] /= norm
+		}
+		gamma = next
+	}
+
+	scale := 400 / math.Ln10
+	ratings := make([]float64, p.n)
+	errs := make([]float64, p.n)
+	for i := 0; i < p.n; i++ {
+		ratings[i] = RATING_MEAN + scale*math.Log(gamma[i])
+		var info float64
+		for j := 0; j < p.n; j++ {
+			if i == j {
+				continue
+			}
+			pij := gamma[i] / (gamma[i] + gamma[j])
+			info += games[i][j] * pij * (1 - pij)
+		}
+		errs[i] = 1.96 * scale / math.Sqrt(info)
+	}
+	return ratings, errs
+}
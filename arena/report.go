@@ -0,0 +1,46 @@
+package arena
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	"scout-go/server"
+)
+
+// WriteTable writes the result as a text table, strongest entrant first
+func (r *Result) WriteTable(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintf(tw, "entrant\tgames\twin rate\tmean score\t±95%%\trating\t±95%%\t\n")
+	for _, e := range r.ranked() {
+		fmt.Fprintf(tw, "%s\t%d\t%.3f\t%.2f\t%.2f\t%.0f\t%.0f\t\n",
+			e.Name, e.Games, e.WinRate, e.MeanScore, e.ScoreCI95, e.Rating, e.RatingCI95)
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+	if r.Aborted > 0 {
+		_, err := fmt.Fprintf(w, "%d games aborted after %d actions\n", r.Aborted, server.MAX_ACTIONS_PER_GAME)
+		return err
+	}
+	return nil
+}
+
+// WriteJSON writes the result as indented JSON
+func (r *Result) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(r)
+}
+
+func (r *Result) ranked() []EntrantResult {
+	ranked := make([]EntrantResult, len(r.Entrants))
+	copy(ranked, r.Entrants)
+	for i := 1; i < len(ranked); i++ {
+		for j := i; j > 0 && ranked[j].Rating > ranked[j-1].Rating; j-- {
+			ranked[j], ranked[j-1] = ranked[j-1], ranked[j]
+		}
+	}
+	return ranked
+}
@@ -0,0 +1,56 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+
+	"scout-go/arena"
+	"scout-go/server"
+)
+
+// runArena implements the `arena` subcommand: play the given policies against each
+// other in every seating arrangement and report how they fared.
+func runArena(args []string) {
+	fs := flag.NewFlagSet("arena", flag.ExitOnError)
+	var (
+		policies = fs.String("policies", "greedy,random", "comma-separated policies, one per seat ("+strings.Join(server.PolicyNames(), ", ")+")")
+		games    = fs.Int("games", 100, "games per seating arrangement")
+		seed     = fs.Int64("seed", 1, "base seed; game i of each arrangement is dealt from seed+i")
+		jsonOut  = fs.String("json", "", "also write the results as JSON to this file (- for stdout)")
+	)
+	fs.Parse(args)
+
+	result, err := arena.Run(arena.Config{
+		Policies: strings.Split(*policies, ","),
+		Games:    *games,
+		Seed:     *seed,
+	})
+	if err != nil {
+		log.Fatalf("arena: %v", err)
+	}
+
+	if err := result.WriteTable(os.Stdout); err != nil {
+		log.Fatalf("arena: %v", err)
+	}
+
+	switch *jsonOut {
+	case "":
+	case "-":
+		fmt.Println()
+		if err := result.WriteJSON(os.Stdout); err != nil {
+			log.Fatalf("arena: %v", err)
+		}
+	default:
+		f, err := os.Create(*jsonOut)
+		if err != nil {
+			log.Fatalf("arena: %v", err)
+		}
+		defer f.Close()
+		if err := result.WriteJSON(f); err != nil {
+			log.Fatalf("arena: %v", err)
+		}
+	}
+}
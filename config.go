@@ -0,0 +1,316 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log/slog"
+	"maps"
+	"os"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"scout-go/server"
+)
+
+// Config is everything the server starts with. the defaults are overridden by the -config JSON
+// file, then by SCOUT_* environment variables, then by flags given on the command line.
+type Config struct {
+	Addr             string            `json:"addr"`
+	TLSCert          string            `json:"tls_cert"`
+	TLSKey           string            `json:"tls_key"`
+	TLSClientCA      string            `json:"tls_client_ca"`
+	ClientIdentities map[string]string `json:"client_identities"`
+	AdminIdentities  []string          `json:"admin_identities"`
+	ShutdownTimeout  Duration          `json:"shutdown_timeout"`
+	AdminToken       string            `json:"admin_token"`
+	Store            string            `json:"store"`
+	StoreDir         string            `json:"store_dir"`
+	CompletedTTL     Duration          `json:"completed_ttl"`
+	IdleTTL          Duration          `json:"idle_ttl"`
+	MaxGames         int               `json:"max_games"`
+	RateLimits       RateLimitConfig   `json:"rate_limits"`
+	Rules            RulesConfig       `json:"rules"`
+	Agents           AgentConfig       `json:"agents"`
+	HTTPAddr         string            `json:"http_addr"`
+	HTTPOrigins      []string          `json:"http_origins"`
+	MetricsAddr      string            `json:"metrics_addr"`
+	LogFormat        string            `json:"log_format"`
+	LogLevel         string            `json:"log_level"`
+}
+
+// RateLimitConfig is the per-client limits; see server.RateLimits
+type RateLimitConfig struct {
+	Key            string  `json:"key"`
+	CreateRate     float64 `json:"create_rate"`
+	CreateBurst    int     `json:"create_burst"`
+	StepRate       float64 `json:"step_rate"`
+	StepBurst      int     `json:"step_burst"`
+	ClientMaxGames int     `json:"client_max_games"`
+}
+
+// RulesConfig is the rule set of games created without one of their own
+type RulesConfig struct {
+	SpectatorVisibility string   `json:"spectator_visibility"`
+	SpectatorDelay      int      `json:"spectator_delay"`
+	TurnTime            Duration `json:"turn_time"`
+	TotalTime           Duration `json:"total_time"`
+	TimeoutPolicy       string   `json:"timeout_policy"`
+}
+
+// AgentConfig is what agents registered without their own timeout or fallback policy get
+type AgentConfig struct {
+	Timeout        Duration `json:"timeout"`
+	FallbackPolicy string   `json:"fallback_policy"`
+}
+
+// Duration is a time.Duration written as a string, e.g. "90s"
+type Duration time.Duration
+
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(time.Duration(d).String())
+}
+
+func (d *Duration) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("invalid duration %s; durations are strings such as \"90s\"", data)
+	}
+	parsed, err := time.ParseDuration(s)
+	if err != nil {
+		return err
+	}
+	*d = Duration(parsed)
+	return nil
+}
+
+var visibilities = map[string]server.Visibility{
+	"public":              server.VisibilityPublic,
+	"full_delayed":        server.VisibilityFullDelayed,
+	"full_after_complete": server.VisibilityFullAfterComplete,
+}
+
+func defaultConfig() Config {
+	return Config{
+		Addr:            ":50051",
+		ShutdownTimeout: Duration(10 * time.Second),
+		Store:           "memory",
+		StoreDir:        "games",
+		RateLimits:      RateLimitConfig{Key: "peer"},
+		Rules:           RulesConfig{SpectatorVisibility: "public", TimeoutPolicy: server.DEFAULT_FALLBACK_POLICY},
+		Agents:          AgentConfig{Timeout: Duration(server.DEFAULT_AGENT_TIMEOUT), FallbackPolicy: server.DEFAULT_FALLBACK_POLICY},
+		LogFormat:       "text",
+		LogLevel:        "info",
+	}
+}
+
+// loadConfig reads the config from the defaults, the -config file, the environment and then
+// args, each overriding the last, and reports whether -print-config was given. the config
+// isn't validated.
+func loadConfig(fs *flag.FlagSet, args []string, lookup func(string) (string, bool)) (Config, bool, error) {
+	config := defaultConfig()
+	file, _ := lookup("SCOUT_CONFIG")
+	configFile := fs.String("config", file, "JSON config file (optional); see Config")
+	printOnly := fs.Bool("print-config", false, "print the effective configuration and exit")
+	bindFlags(fs, &config)
+	if err := fs.Parse(args); err != nil {
+		return Config{}, false, err
+	}
+
+	if *configFile != "" {
+		if err := loadConfigFile(*configFile, &config); err != nil {
+			return Config{}, false, fmt.Errorf("failed to read config: %v", err)
+		}
+	}
+	if err := applyEnv(&config, lookup); err != nil {
+		return Config{}, false, fmt.Errorf("invalid environment: %v", err)
+	}
+	// parse again, so flags given on the command line win over the file and environment
+	if err := fs.Parse(args); err != nil {
+		return Config{}, false, err
+	}
+	return config, *printOnly, nil
+}
+
+// bindFlags gives the settings most often changed their own flags
+func bindFlags(fs *flag.FlagSet, c *Config) {
+	fs.StringVar(&c.Addr, "addr", c.Addr, "gRPC listen address")
+	fs.StringVar(&c.TLSCert, "tls-cert", c.TLSCert, "TLS certificate file (optional)")
+	fs.StringVar(&c.TLSKey, "tls-key", c.TLSKey, "TLS key file (optional)")
+	fs.StringVar(&c.TLSClientCA, "tls-client-ca", c.TLSClientCA, "CA file that client certificates must be signed by; requires them (optional)")
+	fs.DurationVar((*time.Duration)(&c.ShutdownTimeout), "shutdown-timeout", time.Duration(c.ShutdownTimeout), "graceful shutdown timeout")
+	fs.StringVar(&c.AdminToken, "admin-token", c.AdminToken, "token that grants admin access to every seat (optional)")
+	fs.DurationVar((*time.Duration)(&c.CompletedTTL), "completed-ttl", time.Duration(c.CompletedTTL), "remove complete games after this long untouched (0 keeps them)")
+	fs.DurationVar((*time.Duration)(&c.IdleTTL), "idle-ttl", time.Duration(c.IdleTTL), "remove unfinished games after this long untouched (0 keeps them)")
+	fs.IntVar(&c.MaxGames, "max-games", c.MaxGames, "most unfinished games at once (0 for no limit)")
+	fs.StringVar(&c.Store, "store", c.Store, "where games are kept: memory or file")
+	fs.StringVar(&c.StoreDir, "store-dir", c.StoreDir, "directory for -store file")
+	fs.StringVar(&c.HTTPAddr, "http-addr", c.HTTPAddr, "HTTP listen address for the JSON gateway to ScoutService at /v1/ (optional)")
+	fs.StringVar(&c.MetricsAddr, "metrics-addr", c.MetricsAddr, "HTTP listen address for Prometheus metrics at /metrics (optional)")
+	fs.StringVar(&c.LogFormat, "log-format", c.LogFormat, "log output format: text or json")
+	fs.StringVar(&c.LogLevel, "log-level", c.LogLevel, "lowest level logged: debug, info, warn or error")
+	fs.StringVar(&c.RateLimits.Key, "rate-limit-key", c.RateLimits.Key, "what per-client limits are kept by: peer (address) or identity (client certificate or admin token, else address)")
+	fs.Float64Var(&c.RateLimits.CreateRate, "create-rate", c.RateLimits.CreateRate, "game creations per second per client (0 for no limit)")
+	fs.IntVar(&c.RateLimits.CreateBurst, "create-burst", c.RateLimits.CreateBurst, "game creations allowed at once per client (defaults to -create-rate)")
+	fs.Float64Var(&c.RateLimits.StepRate, "step-rate", c.RateLimits.StepRate, "other calls, and stream messages, per second per client (0 for no limit)")
+	fs.IntVar(&c.RateLimits.StepBurst, "step-burst", c.RateLimits.StepBurst, "other calls allowed at once per client (defaults to -step-rate)")
+	fs.IntVar(&c.RateLimits.ClientMaxGames, "client-max-games", c.RateLimits.ClientMaxGames, "most unfinished games one client may have created (0 for no limit)")
+}
+
+// loadConfigFile overrides c with the settings the file gives; the rest are left as they are
+func loadConfigFile(path string, c *Config) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(c); err != nil {
+		return fmt.Errorf("%s: %v", path, err)
+	}
+	return nil
+}
+
+// applyEnv overrides c with SCOUT_* environment variables, named after the JSON keys,
+// e.g. SCOUT_ADDR or SCOUT_RATE_LIMITS_STEP_RATE. lists are comma separated; client_identities
+// can only be set in the file.
+func applyEnv(c *Config, lookup func(string) (string, bool)) error {
+	return applyEnvFields(reflect.ValueOf(c).Elem(), "SCOUT", lookup)
+}
+
+func applyEnvFields(v reflect.Value, prefix string, lookup func(string) (string, bool)) error {
+	for i := 0; i < v.NumField(); i++ {
+		name := prefix + "_" + strings.ToUpper(v.Type().Field(i).Tag.Get("json"))
+		field := v.Field(i)
+		if field.Kind() == reflect.Struct {
+			if err := applyEnvFields(field, name, lookup); err != nil {
+				return err
+			}
+			continue
+		}
+		value, ok := lookup(name)
+		if !ok {
+			continue
+		}
+		var err error
+		switch p := field.Addr().Interface().(type) {
+		case *string:
+			*p = value
+		case *int:
+			*p, err = strconv.Atoi(value)
+		case *float64:
+			*p, err = strconv.ParseFloat(value, 64)
+		case *[]string:
+			*p = strings.Split(value, ",")
+		case *Duration:
+			var d time.Duration
+			d, err = time.ParseDuration(value)
+			*p = Duration(d)
+		}
+		if err != nil {
+			return fmt.Errorf("%s: invalid value %q", name, value)
+		}
+	}
+	return nil
+}
+
+// Validate returns every problem with the config, each naming the setting it is about
+func (c Config) Validate() error {
+	var errs []error
+	check := func(ok bool, key, format string, args ...any) {
+		if !ok {
+			errs = append(errs, fmt.Errorf("%s: %s", key, fmt.Sprintf(format, args...)))
+		}
+	}
+
+	check(c.Addr != "", "addr", "is required")
+	check((c.TLSCert == "") == (c.TLSKey == ""), "tls_cert", "tls_cert and tls_key must be given together")
+	check(c.TLSClientCA == "" || c.TLSCert != "", "tls_client_ca", "requires tls_cert and tls_key")
+	for _, file := range []string{c.TLSCert, c.TLSKey, c.TLSClientCA} {
+		if file != "" {
+			_, err := os.Stat(file)
+			check(err == nil, "tls", "%v", err)
+		}
+	}
+	mapped := make(map[string]bool)
+	for _, subject := range slices.Sorted(maps.Keys(c.ClientIdentities)) {
+		id := c.ClientIdentities[subject]
+		check(id != "" && !strings.HasPrefix(id, server.UNMAPPED_IDENTITY_PREFIX), "client_identities",
+			"%s: identities must not be empty or start with %q", subject, server.UNMAPPED_IDENTITY_PREFIX)
+		mapped[id] = true
+	}
+	for _, id := range c.AdminIdentities {
+		check(mapped[id], "admin_identities", "%q is not an identity in client_identities", id)
+	}
+	check(c.TLSClientCA != "" || (len(c.ClientIdentities) == 0 && len(c.AdminIdentities) == 0),
+		"tls_client_ca", "is required for client_identities and admin_identities")
+	check(c.ShutdownTimeout > 0, "shutdown_timeout", "must be positive")
+	check(c.Store == "memory" || c.Store == "file", "store", "unknown store %q; use memory or file", c.Store)
+	check(c.Store != "file" || c.StoreDir != "", "store_dir", "is required for the file store")
+	check(c.CompletedTTL >= 0, "completed_ttl", "must not be negative")
+	check(c.IdleTTL >= 0, "idle_ttl", "must not be negative")
+	check(c.MaxGames >= 0, "max_games", "must not be negative")
+
+	r := c.RateLimits
+	check(r.Key == "peer" || r.Key == "identity", "rate_limits.key", "unknown key %q; use peer or identity", r.Key)
+	check(r.CreateRate >= 0 && r.StepRate >= 0, "rate_limits", "rates must not be negative")
+	check(r.CreateBurst >= 0 && r.StepBurst >= 0, "rate_limits", "bursts must not be negative")
+	check(r.ClientMaxGames >= 0, "rate_limits.client_max_games", "must not be negative")
+
+	_, ok := visibilities[c.Rules.SpectatorVisibility]
+	check(ok, "rules.spectator_visibility", "unknown visibility %q; use public, full_delayed or full_after_complete", c.Rules.SpectatorVisibility)
+	if ok {
+		err := c.gameConfig().Validate()
+		check(err == nil, "rules", "%v", err)
+	}
+
+	check(c.Agents.Timeout > 0, "agents.timeout", "must be positive")
+	_, err := server.NewPolicy(c.Agents.FallbackPolicy)
+	check(err == nil, "agents.fallback_policy", "%v", err)
+
+	check(c.LogFormat == "text" || c.LogFormat == "json", "log_format", "unknown format %q; use text or json", c.LogFormat)
+	var level slog.Level
+	check(level.UnmarshalText([]byte(c.LogLevel)) == nil, "log_level", "unknown level %q; use debug, info, warn or error", c.LogLevel)
+
+	return errors.Join(errs...)
+}
+
+// gameConfig returns the rule set games are created with by default
+func (c Config) gameConfig() server.GameConfig {
+	return server.GameConfig{
+		SpectatorVisibility: visibilities[c.Rules.SpectatorVisibility],
+		SpectatorDelay:      c.Rules.SpectatorDelay,
+		TurnTime:            time.Duration(c.Rules.TurnTime),
+		TotalTime:           time.Duration(c.Rules.TotalTime),
+		TimeoutPolicy:       c.Rules.TimeoutPolicy,
+	}
+}
+
+func (c Config) rateLimits() server.RateLimits {
+	return server.RateLimits{
+		KeyBy:        c.RateLimits.Key,
+		CreateRate:   c.RateLimits.CreateRate,
+		CreateBurst:  c.RateLimits.CreateBurst,
+		StepRate:     c.RateLimits.StepRate,
+		StepBurst:    c.RateLimits.StepBurst,
+		MaxLiveGames: c.RateLimits.ClientMaxGames,
+	}
+}
+
+// printConfig writes the config as JSON, in the form the -config file takes, with the admin
+// token hidden
+func printConfig(w io.Writer, c Config) {
+	if c.AdminToken != "" {
+		c.AdminToken = "<redacted>"
+	}
+	encoder := json.NewEncoder(w)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	encoder.Encode(c)
+}
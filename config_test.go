@@ -0,0 +1,178 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"scout-go/server"
+)
+
+// env is a fake environment for applyEnv and loadConfig
+func env(vars map[string]string) func(string) (string, bool) {
+	return func(name string) (string, bool) {
+		value, ok := vars[name]
+		return value, ok
+	}
+}
+
+func writeConfig(t *testing.T, data string) string {
+	path := filepath.Join(t.TempDir(), "config.json")
+	if err := os.WriteFile(path, []byte(data), 0600); err != nil {
+		t.Fatalf("failed to write config: %v", err)
+	}
+	return path
+}
+
+func load(t *testing.T, args []string, vars map[string]string) (Config, bool) {
+	fs := flag.NewFlagSet("scout", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	config, printOnly, err := loadConfig(fs, args, env(vars))
+	if err != nil {
+		t.Fatalf("loadConfig returned err: %v", err)
+	}
+	return config, printOnly
+}
+
+func TestConfigPrecedence(t *testing.T) {
+	path := writeConfig(t, `{
+		"addr": ":1000",
+		"max_games": 10,
+		"idle_ttl": "1h",
+		"rules": {"turn_time": "30s"},
+		"rate_limits": {"step_rate": 5}
+	}`)
+
+	// the file overrides the defaults
+	config, printOnly := load(t, []string{"-config", path}, nil)
+	if config.Addr != ":1000" || config.MaxGames != 10 || config.IdleTTL != Duration(time.Hour) || config.Rules.TurnTime != Duration(30*time.Second) {
+		t.Fatalf("expected the file's settings, got %+v", config)
+	}
+	if config.LogFormat != "text" || config.Store != "memory" || printOnly {
+		t.Fatalf("expected the defaults for settings the file leaves out, got %+v", config)
+	}
+
+	// the environment overrides the file, and names it
+	vars := map[string]string{"SCOUT_CONFIG": path, "SCOUT_MAX_GAMES": "20", "SCOUT_RATE_LIMITS_STEP_RATE": "2.5"}
+	config, _ = load(t, nil, vars)
+	if config.Addr != ":1000" || config.MaxGames != 20 || config.RateLimits.StepRate != 2.5 {
+		t.Fatalf("expected the environment over the file, got %+v", config)
+	}
+
+	// flags given on the command line override both; flags left out don't
+	config, printOnly = load(t, []string{"-max-games", "30", "-addr", ":2000", "-print-config"}, vars)
+	if config.Addr != ":2000" || config.MaxGames != 30 || config.RateLimits.StepRate != 2.5 || config.IdleTTL != Duration(time.Hour) || !printOnly {
+		t.Fatalf("expected the flags over the environment, got %+v", config)
+	}
+}
+
+func TestLoadConfigErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		file string
+		vars map[string]string
+		want string
+	}{
+		{"unknown setting", `{"adress": ":1"}`, nil, `unknown field "adress"`},
+		{"bad duration", `{"idle_ttl": 60}`, nil, "invalid duration 60"},
+		{"bad environment", `{}`, map[string]string{"SCOUT_MAX_GAMES": "lots"}, `SCOUT_MAX_GAMES: invalid value "lots"`},
+		{"bad nested environment", `{}`, map[string]string{"SCOUT_AGENTS_TIMEOUT": "soon"}, `SCOUT_AGENTS_TIMEOUT: invalid value "soon"`},
+	}
+
+	for _, test := range tests {
+		fs := flag.NewFlagSet("scout", flag.ContinueOnError)
+		_, _, err := loadConfig(fs, []string{"-config", writeConfig(t, test.file)}, env(test.vars))
+		if err == nil || !strings.Contains(err.Error(), test.want) {
+			t.Fatalf("%s: expected an error containing %q, got %v", test.name, test.want, err)
+		}
+	}
+}
+
+func TestApplyEnv(t *testing.T) {
+	config := defaultConfig()
+	err := applyEnv(&config, env(map[string]string{
+		"SCOUT_HTTP_ORIGINS":          "https://a.example,https://b.example",
+		"SCOUT_SHUTDOWN_TIMEOUT":      "1m",
+		"SCOUT_RULES_SPECTATOR_DELAY": "3",
+		"SCOUT_ADMIN_IDENTITIES":      "ops",
+		"SCOUT_CLIENT_IDENTITIES":     "CN=a:ops",
+	}))
+	if err != nil {
+		t.Fatalf("applyEnv returned err: %v", err)
+	}
+	if !reflect.DeepEqual(config.HTTPOrigins, []string{"https://a.example", "https://b.example"}) {
+		t.Fatalf("expected the list to be split on commas, got %v", config.HTTPOrigins)
+	}
+	if config.ShutdownTimeout != Duration(time.Minute) || config.Rules.SpectatorDelay != 3 || !reflect.DeepEqual(config.AdminIdentities, []string{"ops"}) {
+		t.Fatalf("expected the environment's settings, got %+v", config)
+	}
+	if config.ClientIdentities != nil {
+		t.Fatalf("expected client_identities to be left to the file, got %v", config.ClientIdentities)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	if err := defaultConfig().Validate(); err != nil {
+		t.Fatalf("expected the defaults to be valid, got %v", err)
+	}
+
+	config := defaultConfig()
+	config.Addr = ""
+	config.Store = "s3"
+	config.RateLimits.Key = "ip"
+	config.Rules.SpectatorVisibility = "everything"
+	config.Agents.FallbackPolicy = "psychic"
+	config.LogLevel = "loud"
+	config.AdminIdentities = []string{"ops"}
+	err := config.Validate()
+	if err == nil {
+		t.Fatalf("expected the config to be invalid")
+	}
+	// every problem is listed, each naming its setting
+	for _, key := range []string{"addr:", "store:", "rate_limits.key:", "rules.spectator_visibility:", "agents.fallback_policy:", "log_level:", "admin_identities:", "tls_client_ca:"} {
+		if !strings.Contains(err.Error(), key) {
+			t.Fatalf("expected a problem with %s, got:\n%v", key, err)
+		}
+	}
+
+	// admins must be mapped identities, which can't pass for unmapped certificates
+	config = defaultConfig()
+	config.TLSCert, config.TLSKey, config.TLSClientCA = writeConfig(t, ""), writeConfig(t, ""), writeConfig(t, "")
+	config.ClientIdentities = map[string]string{"CN=alice": "ops", "CN=bob": server.UNMAPPED_IDENTITY_PREFIX + "bob"}
+	config.AdminIdentities = []string{"ops"}
+	if err := config.Validate(); err == nil || !strings.Contains(err.Error(), "CN=bob") {
+		t.Fatalf("expected a problem with CN=bob, got %v", err)
+	}
+	delete(config.ClientIdentities, "CN=bob")
+	if err := config.Validate(); err != nil {
+		t.Fatalf("expected the config to be valid, got %v", err)
+	}
+}
+
+func TestPrintConfig(t *testing.T) {
+	config := defaultConfig()
+	config.AdminToken = "hunter2"
+	config.Rules.TurnTime = Duration(90 * time.Second)
+
+	var out bytes.Buffer
+	printConfig(&out, config)
+	if strings.Contains(out.String(), "hunter2") {
+		t.Fatalf("expected the admin token to be hidden, got:\n%s", out.String())
+	}
+
+	// what it prints can be read back as a config file
+	var printed Config
+	if err := json.Unmarshal(out.Bytes(), &printed); err != nil {
+		t.Fatalf("failed to read the printed config: %v", err)
+	}
+	if printed.AdminToken != "<redacted>" || printed.Rules.TurnTime != config.Rules.TurnTime || printed.Addr != config.Addr {
+		t.Fatalf("expected the config back with its token redacted, got %+v", printed)
+	}
+}
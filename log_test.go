@@ -0,0 +1,30 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestLogRPCKeepsTheMessage(t *testing.T) {
+	var out bytes.Buffer
+	defer slog.SetDefault(slog.Default())
+	slog.SetDefault(slog.New(slog.NewJSONHandler(&out, nil)))
+
+	logRPC(context.Background(), nil, "/scout.ScoutService/GetGameState", time.Millisecond, nil, status.Error(codes.NotFound, "invalid game_id"), nil)
+
+	// a JSON reader keeps the last of any repeated key, so the status can't share the record's
+	var record map[string]any
+	if err := json.Unmarshal(out.Bytes(), &record); err != nil {
+		t.Fatalf("failed to read the log record %q: %v", out.String(), err)
+	}
+	if record["msg"] != "rpc" || record["status_message"] != "invalid game_id" || record["code"] != "NotFound" {
+		t.Fatalf("expected the record's message and the status apart, got %s", out.String())
+	}
+}
@@ -2,10 +2,14 @@ package main
 
 import (
 	"context"
+	"crypto/tls"
 	"flag"
+	"fmt"
 
 	"log"
+	"log/slog"
 	"net"
+	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
@@ -34,30 +38,88 @@ import (
 // To expose your own services, register them inside registerServices(s).
 
 func main() {
-	var (
-		addr            = flag.String("addr", ":50051", "gRPC listen address")
-		certFile        = flag.String("tls-cert", "", "TLS certificate file (optional)")
-		keyFile         = flag.String("tls-key", "", "TLS key file (optional)")
-		shutdownTimeout = flag.Duration("shutdown-timeout", 10*time.Second, "graceful shutdown timeout")
-	)
-	flag.Parse()
-
-	lis, err := net.Listen("tcp", *addr)
+	// subcommands
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "arena":
+			runArena(os.Args[2:])
+			return
+		case "selfplay":
+			runSelfPlay(os.Args[2:])
+			return
+		}
+	}
+
+	config, printOnly, err := loadConfig(flag.CommandLine, os.Args[1:], os.LookupEnv)
+	if err != nil {
+		log.Fatalf("%v", err)
+	}
+	if err := config.Validate(); err != nil {
+		log.Fatalf("invalid config:\n%v", err)
+	}
+	if printOnly {
+		printConfig(os.Stdout, config)
+		return
+	}
+
+	logger, err := newLogger(config.LogFormat, config.LogLevel)
+	if err != nil {
+		log.Fatalf("%v", err)
+	}
+	slog.SetDefault(logger)
+
+	lis, err := net.Listen("tcp", config.Addr)
 	if err != nil {
-		log.Fatalf("failed to listen on %s: %v", *addr, err)
+		log.Fatalf("failed to listen on %s: %v", config.Addr, err)
+	}
+
+	var store server.GameStore
+	switch config.Store {
+	case "memory":
+		store = server.NewMemoryStore()
+	case "file":
+		store, err = server.NewFileStore(config.StoreDir)
+		if err != nil {
+			log.Fatalf("failed to open game store in %s: %v", config.StoreDir, err)
+		}
 	}
 
 	var opts []grpc.ServerOption
 	// Interceptors
-	opts = append(opts, grpc.ChainUnaryInterceptor(loggingUnaryInterceptor, recoveryUnaryInterceptor))
-
-	// TLS if specified
-	if *certFile != "" && *keyFile != "" {
-		creds, err := credentials.NewServerTLSFromFile(*certFile, *keyFile)
+	var metrics *server.Metrics
+	if config.MetricsAddr != "" {
+		metrics = server.NewMetrics()
+	}
+	identities := server.ClientIdentities(config.ClientIdentities)
+	unary := []grpc.UnaryServerInterceptor{loggingUnaryInterceptor(identities), metricsUnaryInterceptor(metrics)}
+	stream := []grpc.StreamServerInterceptor{loggingStreamInterceptor(identities), metricsStreamInterceptor(metrics)}
+	var limiter *server.RateLimiter
+	if limits := config.RateLimits; limits.CreateRate > 0 || limits.StepRate > 0 || limits.ClientMaxGames > 0 {
+		limiter, err = server.NewRateLimiter(config.rateLimits())
 		if err != nil {
-			log.Fatalf("failed to load TLS credentials: %v", err)
+			log.Fatalf("%v", err)
 		}
-		opts = append(opts, grpc.Creds(creds))
+		unary = append(unary, limiter.UnaryInterceptor)
+		stream = append(stream, limiter.StreamInterceptor)
+	}
+	unary = append(unary, recoveryUnaryInterceptor)
+	opts = append(opts, grpc.ChainUnaryInterceptor(unary...))
+	opts = append(opts, grpc.ChainStreamInterceptor(stream...))
+
+	// TLS if specified, and client certificates if a client CA is
+	var tlsConfig *tls.Config
+	if config.TLSClientCA != "" {
+		tlsConfig, err = server.MutualTLSConfig(config.TLSCert, config.TLSKey, config.TLSClientCA)
+	} else if config.TLSCert != "" && config.TLSKey != "" {
+		var cert tls.Certificate
+		cert, err = tls.LoadX509KeyPair(config.TLSCert, config.TLSKey)
+		tlsConfig = &tls.Config{Certificates: []tls.Certificate{cert}}
+	}
+	if err != nil {
+		log.Fatalf("failed to load TLS credentials: %v", err)
+	}
+	if tlsConfig != nil {
+		opts = append(opts, grpc.Creds(credentials.NewTLS(tlsConfig)))
 	}
 
 	grpcServer := grpc.NewServer(opts...)
@@ -71,12 +133,66 @@ func main() {
 	reflection.Register(grpcServer)
 
 	// Place to register your own services:
-	registerServices(grpcServer)
+	serverOpts := []server.Option{
+		server.WithStore(store),
+		server.WithAdminToken(config.AdminToken),
+		server.WithCompletedTTL(time.Duration(config.CompletedTTL)),
+		server.WithIdleTTL(time.Duration(config.IdleTTL)),
+		server.WithMaxGames(config.MaxGames),
+		server.WithDefaultGameConfig(config.gameConfig()),
+		server.WithAgentDefaults(time.Duration(config.Agents.Timeout), config.Agents.FallbackPolicy),
+		server.WithClientIdentities(identities, config.AdminIdentities...),
+	}
+	if metrics != nil {
+		serverOpts = append(serverOpts, server.WithMetrics(metrics))
+	}
+	if limiter != nil {
+		serverOpts = append(serverOpts, server.WithRateLimiter(limiter))
+	}
+	scoutServer := registerServices(grpcServer, serverOpts...)
+
+	// Metrics over HTTP
+	var metricsServer *http.Server
+	if metrics != nil {
+		mux := http.NewServeMux()
+		mux.Handle("/metrics", metrics)
+		metricsServer = &http.Server{Addr: config.MetricsAddr, Handler: mux}
+		go func() {
+			slog.Info("serving metrics", "addr", config.MetricsAddr, "path", "/metrics")
+			if err := metricsServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+				slog.Error("metrics server stopped with error", "err", err)
+			}
+		}()
+	}
+
+	// JSON over HTTP, through the same interceptors and with the same TLS
+	var gatewayServer *http.Server
+	if config.HTTPAddr != "" {
+		gateway := server.NewGateway(scoutServer, unary, stream)
+		gateway.AllowedOrigins = config.HTTPOrigins
+		gatewayServer = &http.Server{
+			Addr:      config.HTTPAddr,
+			Handler:   gateway,
+			TLSConfig: tlsConfig,
+		}
+		go func() {
+			slog.Info("serving HTTP gateway", "addr", config.HTTPAddr, "tls", tlsConfig != nil)
+			var err error
+			if tlsConfig != nil {
+				err = gatewayServer.ListenAndServeTLS("", "")
+			} else {
+				err = gatewayServer.ListenAndServe()
+			}
+			if err != nil && err != http.ErrServerClosed {
+				slog.Error("HTTP gateway stopped with error", "err", err)
+			}
+		}()
+	}
 
 	// Serve in goroutine
 	serverErrCh := make(chan error, 1)
 	go func() {
-		log.Printf("starting gRPC server on %s (tls=%v)", *addr, *certFile != "" && *keyFile != "")
+		slog.Info("starting gRPC server", "addr", config.Addr, "tls", config.TLSCert != "", "mtls", config.TLSClientCA != "")
 		if err := grpcServer.Serve(lis); err != nil {
 			serverErrCh <- err
 		}
@@ -89,12 +205,12 @@ func main() {
 
 	select {
 	case sig := <-sigCh:
-		log.Printf("received signal %v, initiating graceful shutdown", sig)
+		slog.Info("received signal, initiating graceful shutdown", "signal", sig.String())
 	case err := <-serverErrCh:
 		if err != nil {
-			log.Printf("server stopped with error: %v", err)
+			slog.Error("server stopped with error", "err", err)
 		} else {
-			log.Printf("server stopped")
+			slog.Info("server stopped")
 		}
 	}
 
@@ -107,48 +223,160 @@ func main() {
 
 	select {
 	case <-done:
-		log.Printf("graceful shutdown completed")
-	case <-time.After(*shutdownTimeout):
-		log.Printf("graceful shutdown timed out after %s, forcing stop", shutdownTimeout.String())
+		slog.Info("graceful shutdown completed")
+	case <-time.After(time.Duration(config.ShutdownTimeout)):
+		slog.Warn("graceful shutdown timed out, forcing stop", "timeout", time.Duration(config.ShutdownTimeout))
 		grpcServer.Stop()
 	}
 
+	if gatewayServer != nil {
+		gatewayServer.Close()
+	}
+	scoutServer.Close()
+	if metricsServer != nil {
+		metricsServer.Close()
+	}
+
 	// set health to NOT_SERVING before exit
 	healthSrv.SetServingStatus("", healthpb.HealthCheckResponse_NOT_SERVING)
-	log.Printf("server exited")
+	slog.Info("server exited")
 }
 
 // registerServices is a placeholder where you should register your gRPC services.
 // Example (requires generated pb code):
 //
 //	pb.RegisterYourServiceServer(s, &yourServiceImpl{})
-func registerServices(s *grpc.Server) {
-	scoutServer := server.NewScoutServer()
+func registerServices(s *grpc.Server, opts ...server.Option) *server.ScoutServer {
+	scoutServer := server.NewScoutServer(opts...)
 	pb.RegisterScoutServiceServer(s, scoutServer)
+	return scoutServer
 }
 
-// loggingUnaryInterceptor logs basic info about each unary RPC.
-func loggingUnaryInterceptor(
-	ctx context.Context,
-	req interface{},
-	info *grpc.UnaryServerInfo,
-	handler grpc.UnaryHandler,
-) (resp interface{}, err error) {
-	start := time.Now()
-	p, _ := peer.FromContext(ctx)
-	resp, err = handler(ctx, req)
-	duration := time.Since(start)
+// newLogger builds the server's logger: format is text or json, level one of debug, info, warn or error.
+func newLogger(format, level string) (*slog.Logger, error) {
+	var lvl slog.Level
+	if err := lvl.UnmarshalText([]byte(level)); err != nil {
+		return nil, fmt.Errorf("unknown log level %q", level)
+	}
+	opts := &slog.HandlerOptions{Level: lvl}
+	switch format {
+	case "text":
+		return slog.New(slog.NewTextHandler(os.Stderr, opts)), nil
+	case "json":
+		return slog.New(slog.NewJSONHandler(os.Stderr, opts)), nil
+	}
+	return nil, fmt.Errorf("unknown log format %q", format)
+}
+
+// loggingUnaryInterceptor logs each unary RPC, with the client identity, game, seat, action and
+// rule violation it concerns.
+func loggingUnaryInterceptor(ids server.ClientIdentities) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (resp interface{}, err error) {
+		start := time.Now()
+		resp, err = handler(ctx, req)
+
+		attrs := messageAttrs(req)
+		if !hasGameId(req) {
+			// e.g. CreateGame, which only learns its game id once it has made the game
+			attrs = append(attrs, messageAttrs(resp)...)
+		}
+		logRPC(ctx, ids, info.FullMethod, time.Since(start), resp, err, attrs)
+		return resp, err
+	}
+}
+
+// loggingStreamInterceptor logs each streaming RPC when it ends, with the game and seat its
+// first message named
+func loggingStreamInterceptor(ids server.ClientIdentities) grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		start := time.Now()
+		stream := &loggedStream{ServerStream: ss}
+		err := handler(srv, stream)
+		logRPC(ss.Context(), ids, info.FullMethod, time.Since(start), nil, err, stream.attrs)
+		return err
+	}
+}
 
+// loggedStream remembers the log attributes of the first message that names a game
+type loggedStream struct {
+	grpc.ServerStream
+	attrs []any
+}
+
+func (s *loggedStream) RecvMsg(m interface{}) error {
+	err := s.ServerStream.RecvMsg(m)
+	if err == nil && s.attrs == nil && hasGameId(m) {
+		s.attrs = messageAttrs(m)
+	}
+	return err
+}
+
+func logRPC(ctx context.Context, ids server.ClientIdentities, method string, duration time.Duration, resp interface{}, err error, attrs []any) {
 	clientAddr := "unknown"
-	if p != nil {
+	if p, ok := peer.FromContext(ctx); ok {
 		clientAddr = p.Addr.String()
 	}
-
 	st := status.Convert(err)
-	log.Printf("method=%s client=%s duration=%s code=%s msg=%q",
-		info.FullMethod, clientAddr, duration, st.Code(), st.Message())
 
-	return resp, err
+	violation := pb.ViolationCode_ViolationUnknown
+	if r, ok := resp.(interface{ GetViolationCode() pb.ViolationCode }); ok {
+		violation = r.GetViolationCode()
+	}
+	for _, detail := range st.Details() {
+		if v, ok := detail.(*pb.RuleViolation); ok {
+			violation = v.GetCode()
+		}
+	}
+	if violation != pb.ViolationCode_ViolationUnknown {
+		attrs = append(attrs, "violation_code", violation.String())
+	}
+
+	level := slog.LevelInfo
+	switch st.Code() {
+	case codes.Internal, codes.Unknown, codes.DataLoss:
+		level = slog.LevelError
+	}
+	if id := ids.Identify(ctx); id != "" {
+		attrs = append([]any{"identity", id}, attrs...)
+	}
+	attrs = append([]any{"method", method, "client", clientAddr, "duration", duration, "code", st.Code().String(), "status_message", st.Message()}, attrs...)
+	slog.Log(ctx, level, "rpc", attrs...)
+}
+
+// messageAttrs returns log attributes for the game, seat and action a request or response names
+func messageAttrs(m interface{}) []any {
+	if r, ok := m.(*pb.PlaySessionRequest); ok && r.GetJoin() != nil {
+		m = r.GetJoin()
+	}
+	var attrs []any
+	if hasGameId(m) {
+		attrs = append(attrs, "game_id", m.(interface{ GetGameId() string }).GetGameId())
+	}
+	if r, ok := m.(interface{ GetPlayerIndex() int32 }); ok {
+		attrs = append(attrs, "player_index", r.GetPlayerIndex())
+	}
+	if r, ok := m.(interface{ GetAction() *pb.Action }); ok && r.GetAction() != nil {
+		attrs = append(attrs, "action_type", r.GetAction().GetActionType().String())
+	}
+	return attrs
+}
+
+func hasGameId(m interface{}) bool {
+	if r, ok := m.(*pb.PlaySessionRequest); ok {
+		return r.GetJoin().GetGameId() != ""
+	}
+	r, ok := m.(interface{ GetGameId() string })
+	return ok && r.GetGameId() != ""
 }
 
 // recoveryUnaryInterceptor recovers from panics in handlers and returns an INTERNAL error.
@@ -166,3 +394,33 @@ func recoveryUnaryInterceptor(
 	}()
 	return handler(ctx, req)
 }
+
+// metricsUnaryInterceptor records each unary RPC's status code and latency. a nil m records nothing.
+func metricsUnaryInterceptor(m *server.Metrics) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (resp interface{}, err error) {
+		start := time.Now()
+		resp, err = handler(ctx, req)
+		m.ObserveRPC(info.FullMethod, status.Code(err).String(), time.Since(start))
+		return resp, err
+	}
+}
+
+// metricsStreamInterceptor records each streaming RPC's status code and how long it stayed open.
+func metricsStreamInterceptor(m *server.Metrics) grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		start := time.Now()
+		err := handler(srv, ss)
+		m.ObserveRPC(info.FullMethod, status.Code(err).String(), time.Since(start))
+		return err
+	}
+}
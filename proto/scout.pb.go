@@ -21,6 +21,198 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type GamePhase int32
+
+const (
+	GamePhase_PhaseLobby    GamePhase = 0
+	GamePhase_PhasePlaying  GamePhase = 1
+	GamePhase_PhaseComplete GamePhase = 2
+)
+
+// Enum value maps for GamePhase.
+var (
+	GamePhase_name = map[int32]string{
+		0: "PhaseLobby",
+		1: "PhasePlaying",
+		2: "PhaseComplete",
+	}
+	GamePhase_value = map[string]int32{
+		"PhaseLobby":    0,
+		"PhasePlaying":  1,
+		"PhaseComplete": 2,
+	}
+)
+
+func (x GamePhase) Enum() *GamePhase {
+	p := new(GamePhase)
+	*p = x
+	return p
+}
+
+func (x GamePhase) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GamePhase) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_scout_proto_enumTypes[0].Descriptor()
+}
+
+func (GamePhase) Type() protoreflect.EnumType {
+	return &file_proto_scout_proto_enumTypes[0]
+}
+
+func (x GamePhase) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GamePhase.Descriptor instead.
+func (GamePhase) EnumDescriptor() ([]byte, []int) {
+	return file_proto_scout_proto_rawDescGZIP(), []int{0}
+}
+
+type Visibility int32
+
+const (
+	Visibility_VisibilityPublic            Visibility = 0
+	Visibility_VisibilityFullDelayed       Visibility = 1
+	Visibility_VisibilityFullAfterComplete Visibility = 2
+)
+
+// Enum value maps for Visibility.
+var (
+	Visibility_name = map[int32]string{
+		0: "VisibilityPublic",
+		1: "VisibilityFullDelayed",
+		2: "VisibilityFullAfterComplete",
+	}
+	Visibility_value = map[string]int32{
+		"VisibilityPublic":            0,
+		"VisibilityFullDelayed":       1,
+		"VisibilityFullAfterComplete": 2,
+	}
+)
+
+func (x Visibility) Enum() *Visibility {
+	p := new(Visibility)
+	*p = x
+	return p
+}
+
+func (x Visibility) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Visibility) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_scout_proto_enumTypes[1].Descriptor()
+}
+
+func (Visibility) Type() protoreflect.EnumType {
+	return &file_proto_scout_proto_enumTypes[1]
+}
+
+func (x Visibility) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Visibility.Descriptor instead.
+func (Visibility) EnumDescriptor() ([]byte, []int) {
+	return file_proto_scout_proto_rawDescGZIP(), []int{1}
+}
+
+type ViolationCode int32
+
+const (
+	ViolationCode_ViolationUnknown                     ViolationCode = 0
+	ViolationCode_ViolationNotYourTurn                 ViolationCode = 1
+	ViolationCode_ViolationGameComplete                ViolationCode = 2
+	ViolationCode_ViolationGameNotStarted              ViolationCode = 3
+	ViolationCode_ViolationGameStarted                 ViolationCode = 4
+	ViolationCode_ViolationUnknownAction               ViolationCode = 5
+	ViolationCode_ViolationIndexOutOfRange             ViolationCode = 6
+	ViolationCode_ViolationNothingToScout              ViolationCode = 7
+	ViolationCode_ViolationScoutNotFromEnd             ViolationCode = 8
+	ViolationCode_ViolationSetEmpty                    ViolationCode = 9
+	ViolationCode_ViolationSetNotConsecutiveOrMatching ViolationCode = 10
+	ViolationCode_ViolationSetTooWeak                  ViolationCode = 11
+	ViolationCode_ViolationScoutAndShowUsed            ViolationCode = 12
+	ViolationCode_ViolationReverseHandNotAllowed       ViolationCode = 13
+	ViolationCode_ViolationGameFull                    ViolationCode = 14
+	ViolationCode_ViolationSeatTaken                   ViolationCode = 15
+	ViolationCode_ViolationSeatEmpty                   ViolationCode = 16
+	ViolationCode_ViolationNameTooLong                 ViolationCode = 17
+)
+
+// Enum value maps for ViolationCode.
+var (
+	ViolationCode_name = map[int32]string{
+		0:  "ViolationUnknown",
+		1:  "ViolationNotYourTurn",
+		2:  "ViolationGameComplete",
+		3:  "ViolationGameNotStarted",
+		4:  "ViolationGameStarted",
+		5:  "ViolationUnknownAction",
+		6:  "ViolationIndexOutOfRange",
+		7:  "ViolationNothingToScout",
+		8:  "ViolationScoutNotFromEnd",
+		9:  "ViolationSetEmpty",
+		10: "ViolationSetNotConsecutiveOrMatching",
+		11: "ViolationSetTooWeak",
+		12: "ViolationScoutAndShowUsed",
+		13: "ViolationReverseHandNotAllowed",
+		14: "ViolationGameFull",
+		15: "ViolationSeatTaken",
+		16: "ViolationSeatEmpty",
+		17: "ViolationNameTooLong",
+	}
+	ViolationCode_value = map[string]int32{
+		"ViolationUnknown":                     0,
+		"ViolationNotYourTurn":                 1,
+		"ViolationGameComplete":                2,
+		"ViolationGameNotStarted":              3,
+		"ViolationGameStarted":                 4,
+		"ViolationUnknownAction":               5,
+		"ViolationIndexOutOfRange":             6,
+		"ViolationNothingToScout":              7,
+		"ViolationScoutNotFromEnd":             8,
+		"ViolationSetEmpty":                    9,
+		"ViolationSetNotConsecutiveOrMatching": 10,
+		"ViolationSetTooWeak":                  11,
+		"ViolationScoutAndShowUsed":            12,
+		"ViolationReverseHandNotAllowed":       13,
+		"ViolationGameFull":                    14,
+		"ViolationSeatTaken":                   15,
+		"ViolationSeatEmpty":                   16,
+		"ViolationNameTooLong":                 17,
+	}
+)
+
+func (x ViolationCode) Enum() *ViolationCode {
+	p := new(ViolationCode)
+	*p = x
+	return p
+}
+
+func (x ViolationCode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ViolationCode) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_scout_proto_enumTypes[2].Descriptor()
+}
+
+func (ViolationCode) Type() protoreflect.EnumType {
+	return &file_proto_scout_proto_enumTypes[2]
+}
+
+func (x ViolationCode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ViolationCode.Descriptor instead.
+func (ViolationCode) EnumDescriptor() ([]byte, []int) {
+	return file_proto_scout_proto_rawDescGZIP(), []int{2}
+}
+
 type Action_ActionType int32
 
 const (
@@ -63,11 +255,11 @@ func (x Action_ActionType) String() string {
 }
 
 func (Action_ActionType) Descriptor() protoreflect.EnumDescriptor {
-	return file_proto_scout_proto_enumTypes[0].Descriptor()
+	return file_proto_scout_proto_enumTypes[3].Descriptor()
 }
 
 func (Action_ActionType) Type() protoreflect.EnumType {
-	return &file_proto_scout_proto_enumTypes[0]
+	return &file_proto_scout_proto_enumTypes[3]
 }
 
 func (x Action_ActionType) Number() protoreflect.EnumNumber {
@@ -79,6 +271,61 @@ func (Action_ActionType) EnumDescriptor() ([]byte, []int) {
 	return file_proto_scout_proto_rawDescGZIP(), []int{0, 0}
 }
 
+type GameEvent_EventType int32
+
+const (
+	GameEvent_EventActionApplied GameEvent_EventType = 0
+	GameEvent_EventScoreChanged  GameEvent_EventType = 1
+	GameEvent_EventRoundEnded    GameEvent_EventType = 2
+	GameEvent_EventGameEnded     GameEvent_EventType = 3
+	GameEvent_EventGameStarted   GameEvent_EventType = 4
+)
+
+// Enum value maps for GameEvent_EventType.
+var (
+	GameEvent_EventType_name = map[int32]string{
+		0: "EventActionApplied",
+		1: "EventScoreChanged",
+		2: "EventRoundEnded",
+		3: "EventGameEnded",
+		4: "EventGameStarted",
+	}
+	GameEvent_EventType_value = map[string]int32{
+		"EventActionApplied": 0,
+		"EventScoreChanged":  1,
+		"EventRoundEnded":    2,
+		"EventGameEnded":     3,
+		"EventGameStarted":   4,
+	}
+)
+
+func (x GameEvent_EventType) Enum() *GameEvent_EventType {
+	p := new(GameEvent_EventType)
+	*p = x
+	return p
+}
+
+func (x GameEvent_EventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GameEvent_EventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_scout_proto_enumTypes[4].Descriptor()
+}
+
+func (GameEvent_EventType) Type() protoreflect.EnumType {
+	return &file_proto_scout_proto_enumTypes[4]
+}
+
+func (x GameEvent_EventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GameEvent_EventType.Descriptor instead.
+func (GameEvent_EventType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_scout_proto_rawDescGZIP(), []int{27, 0}
+}
+
 type Action struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	Id             int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -174,6 +421,11 @@ type Game struct {
 	Round                int32                  `protobuf:"varint,7,opt,name=round,proto3" json:"round,omitempty"`
 	Complete             bool                   `protobuf:"varint,8,opt,name=complete,proto3" json:"complete,omitempty"`
 	PlayerStates         []*PlayerState         `protobuf:"bytes,9,rep,name=player_states,json=playerStates,proto3" json:"player_states,omitempty"`
+	Phase                GamePhase              `protobuf:"varint,10,opt,name=phase,proto3,enum=scout.GamePhase" json:"phase,omitempty"`
+	Config               *GameConfig            `protobuf:"bytes,11,opt,name=config,proto3" json:"config,omitempty"`
+	TurnTimeRemainingMs  int64                  `protobuf:"varint,12,opt,name=turn_time_remaining_ms,json=turnTimeRemainingMs,proto3" json:"turn_time_remaining_ms,omitempty"`
+	CreatedAtMs          int64                  `protobuf:"varint,13,opt,name=created_at_ms,json=createdAtMs,proto3" json:"created_at_ms,omitempty"`
+	UpdatedAtMs          int64                  `protobuf:"varint,14,opt,name=updated_at_ms,json=updatedAtMs,proto3" json:"updated_at_ms,omitempty"`
 	unknownFields        protoimpl.UnknownFields
 	sizeCache            protoimpl.SizeCache
 }
@@ -271,6 +523,127 @@ func (x *Game) GetPlayerStates() []*PlayerState {
 	return nil
 }
 
+func (x *Game) GetPhase() GamePhase {
+	if x != nil {
+		return x.Phase
+	}
+	return GamePhase_PhaseLobby
+}
+
+func (x *Game) GetConfig() *GameConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *Game) GetTurnTimeRemainingMs() int64 {
+	if x != nil {
+		return x.TurnTimeRemainingMs
+	}
+	return 0
+}
+
+func (x *Game) GetCreatedAtMs() int64 {
+	if x != nil {
+		return x.CreatedAtMs
+	}
+	return 0
+}
+
+func (x *Game) GetUpdatedAtMs() int64 {
+	if x != nil {
+		return x.UpdatedAtMs
+	}
+	return 0
+}
+
+// a game's options. CreateGame keeps the server's default for any option left unset, so
+// setting one to zero, e.g. turn_time_ms, turns it off
+type GameConfig struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	SpectatorVisibility *Visibility            `protobuf:"varint,1,opt,name=spectator_visibility,json=spectatorVisibility,proto3,enum=scout.Visibility,oneof" json:"spectator_visibility,omitempty"`
+	SpectatorDelay      *int32                 `protobuf:"varint,2,opt,name=spectator_delay,json=spectatorDelay,proto3,oneof" json:"spectator_delay,omitempty"`
+	TurnTimeMs          *int64                 `protobuf:"varint,3,opt,name=turn_time_ms,json=turnTimeMs,proto3,oneof" json:"turn_time_ms,omitempty"`
+	TotalTimeMs         *int64                 `protobuf:"varint,4,opt,name=total_time_ms,json=totalTimeMs,proto3,oneof" json:"total_time_ms,omitempty"`
+	TimeoutPolicy       *string                `protobuf:"bytes,5,opt,name=timeout_policy,json=timeoutPolicy,proto3,oneof" json:"timeout_policy,omitempty"`
+	DebugLog            *bool                  `protobuf:"varint,6,opt,name=debug_log,json=debugLog,proto3,oneof" json:"debug_log,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *GameConfig) Reset() {
+	*x = GameConfig{}
+	mi := &file_proto_scout_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GameConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameConfig) ProtoMessage() {}
+
+func (x *GameConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scout_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameConfig.ProtoReflect.Descriptor instead.
+func (*GameConfig) Descriptor() ([]byte, []int) {
+	return file_proto_scout_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GameConfig) GetSpectatorVisibility() Visibility {
+	if x != nil && x.SpectatorVisibility != nil {
+		return *x.SpectatorVisibility
+	}
+	return Visibility_VisibilityPublic
+}
+
+func (x *GameConfig) GetSpectatorDelay() int32 {
+	if x != nil && x.SpectatorDelay != nil {
+		return *x.SpectatorDelay
+	}
+	return 0
+}
+
+func (x *GameConfig) GetTurnTimeMs() int64 {
+	if x != nil && x.TurnTimeMs != nil {
+		return *x.TurnTimeMs
+	}
+	return 0
+}
+
+func (x *GameConfig) GetTotalTimeMs() int64 {
+	if x != nil && x.TotalTimeMs != nil {
+		return *x.TotalTimeMs
+	}
+	return 0
+}
+
+func (x *GameConfig) GetTimeoutPolicy() string {
+	if x != nil && x.TimeoutPolicy != nil {
+		return *x.TimeoutPolicy
+	}
+	return ""
+}
+
+func (x *GameConfig) GetDebugLog() bool {
+	if x != nil && x.DebugLog != nil {
+		return *x.DebugLog
+	}
+	return false
+}
+
 type Player struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	Name            string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
@@ -285,7 +658,7 @@ type Player struct {
 
 func (x *Player) Reset() {
 	*x = Player{}
-	mi := &file_proto_scout_proto_msgTypes[2]
+	mi := &file_proto_scout_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -297,7 +670,7 @@ func (x *Player) String() string {
 func (*Player) ProtoMessage() {}
 
 func (x *Player) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_scout_proto_msgTypes[2]
+	mi := &file_proto_scout_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -310,7 +683,7 @@ func (x *Player) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Player.ProtoReflect.Descriptor instead.
 func (*Player) Descriptor() ([]byte, []int) {
-	return file_proto_scout_proto_rawDescGZIP(), []int{2}
+	return file_proto_scout_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *Player) GetName() string {
@@ -365,7 +738,7 @@ type Card struct {
 
 func (x *Card) Reset() {
 	*x = Card{}
-	mi := &file_proto_scout_proto_msgTypes[3]
+	mi := &file_proto_scout_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -377,7 +750,7 @@ func (x *Card) String() string {
 func (*Card) ProtoMessage() {}
 
 func (x *Card) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_scout_proto_msgTypes[3]
+	mi := &file_proto_scout_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -390,7 +763,7 @@ func (x *Card) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Card.ProtoReflect.Descriptor instead.
 func (*Card) Descriptor() ([]byte, []int) {
-	return file_proto_scout_proto_rawDescGZIP(), []int{3}
+	return file_proto_scout_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *Card) GetValue1() int32 {
@@ -408,17 +781,23 @@ func (x *Card) GetValue2() int32 {
 }
 
 type PlayerState struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	PlayerIndex   int32                  `protobuf:"varint,1,opt,name=player_index,json=playerIndex,proto3" json:"player_index,omitempty"`
-	HandSize      int32                  `protobuf:"varint,2,opt,name=hand_size,json=handSize,proto3" json:"hand_size,omitempty"`
-	Score         int32                  `protobuf:"varint,3,opt,name=score,proto3" json:"score,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	PlayerIndex     int32                  `protobuf:"varint,1,opt,name=player_index,json=playerIndex,proto3" json:"player_index,omitempty"`
+	HandSize        int32                  `protobuf:"varint,2,opt,name=hand_size,json=handSize,proto3" json:"hand_size,omitempty"`
+	Score           int32                  `protobuf:"varint,3,opt,name=score,proto3" json:"score,omitempty"`
+	PublicCards     []*PublicCard          `protobuf:"bytes,4,rep,name=public_cards,json=publicCards,proto3" json:"public_cards,omitempty"`
+	Name            string                 `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
+	Seated          bool                   `protobuf:"varint,6,opt,name=seated,proto3" json:"seated,omitempty"`
+	Ready           bool                   `protobuf:"varint,7,opt,name=ready,proto3" json:"ready,omitempty"`
+	TimeRemainingMs int64                  `protobuf:"varint,8,opt,name=time_remaining_ms,json=timeRemainingMs,proto3" json:"time_remaining_ms,omitempty"`
+	Timeouts        int32                  `protobuf:"varint,9,opt,name=timeouts,proto3" json:"timeouts,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *PlayerState) Reset() {
 	*x = PlayerState{}
-	mi := &file_proto_scout_proto_msgTypes[4]
+	mi := &file_proto_scout_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -430,7 +809,7 @@ func (x *PlayerState) String() string {
 func (*PlayerState) ProtoMessage() {}
 
 func (x *PlayerState) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_scout_proto_msgTypes[4]
+	mi := &file_proto_scout_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -443,7 +822,7 @@ func (x *PlayerState) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PlayerState.ProtoReflect.Descriptor instead.
 func (*PlayerState) Descriptor() ([]byte, []int) {
-	return file_proto_scout_proto_rawDescGZIP(), []int{4}
+	return file_proto_scout_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *PlayerState) GetPlayerIndex() int32 {
@@ -467,71 +846,70 @@ func (x *PlayerState) GetScore() int32 {
 	return 0
 }
 
-type CreateGameRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	NumPlayers    int32                  `protobuf:"varint,1,opt,name=num_players,json=numPlayers,proto3" json:"num_players,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *PlayerState) GetPublicCards() []*PublicCard {
+	if x != nil {
+		return x.PublicCards
+	}
+	return nil
 }
 
-func (x *CreateGameRequest) Reset() {
-	*x = CreateGameRequest{}
-	mi := &file_proto_scout_proto_msgTypes[5]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *PlayerState) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
 }
 
-func (x *CreateGameRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *PlayerState) GetSeated() bool {
+	if x != nil {
+		return x.Seated
+	}
+	return false
 }
 
-func (*CreateGameRequest) ProtoMessage() {}
-
-func (x *CreateGameRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_scout_proto_msgTypes[5]
+func (x *PlayerState) GetReady() bool {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Ready
 	}
-	return mi.MessageOf(x)
+	return false
 }
 
-// Deprecated: Use CreateGameRequest.ProtoReflect.Descriptor instead.
-func (*CreateGameRequest) Descriptor() ([]byte, []int) {
-	return file_proto_scout_proto_rawDescGZIP(), []int{5}
+func (x *PlayerState) GetTimeRemainingMs() int64 {
+	if x != nil {
+		return x.TimeRemainingMs
+	}
+	return 0
 }
 
-func (x *CreateGameRequest) GetNumPlayers() int32 {
+func (x *PlayerState) GetTimeouts() int32 {
 	if x != nil {
-		return x.NumPlayers
+		return x.Timeouts
 	}
 	return 0
 }
 
-type CreateGameResponse struct {
+type PublicCard struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Position      int32                  `protobuf:"varint,1,opt,name=position,proto3" json:"position,omitempty"`
+	Card          *Card                  `protobuf:"bytes,2,opt,name=card,proto3" json:"card,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateGameResponse) Reset() {
-	*x = CreateGameResponse{}
+func (x *PublicCard) Reset() {
+	*x = PublicCard{}
 	mi := &file_proto_scout_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateGameResponse) String() string {
+func (x *PublicCard) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateGameResponse) ProtoMessage() {}
+func (*PublicCard) ProtoMessage() {}
 
-func (x *CreateGameResponse) ProtoReflect() protoreflect.Message {
+func (x *PublicCard) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_scout_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -543,30 +921,150 @@ func (x *CreateGameResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateGameResponse.ProtoReflect.Descriptor instead.
-func (*CreateGameResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use PublicCard.ProtoReflect.Descriptor instead.
+func (*PublicCard) Descriptor() ([]byte, []int) {
 	return file_proto_scout_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *CreateGameResponse) GetGameId() string {
+func (x *PublicCard) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *PublicCard) GetCard() *Card {
+	if x != nil {
+		return x.Card
+	}
+	return nil
+}
+
+type CreateGameRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NumPlayers    int32                  `protobuf:"varint,1,opt,name=num_players,json=numPlayers,proto3" json:"num_players,omitempty"`
+	Open          bool                   `protobuf:"varint,2,opt,name=open,proto3" json:"open,omitempty"`
+	Config        *GameConfig            `protobuf:"bytes,3,opt,name=config,proto3" json:"config,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateGameRequest) Reset() {
+	*x = CreateGameRequest{}
+	mi := &file_proto_scout_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGameRequest) ProtoMessage() {}
+
+func (x *CreateGameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scout_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGameRequest.ProtoReflect.Descriptor instead.
+func (*CreateGameRequest) Descriptor() ([]byte, []int) {
+	return file_proto_scout_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CreateGameRequest) GetNumPlayers() int32 {
+	if x != nil {
+		return x.NumPlayers
+	}
+	return 0
+}
+
+func (x *CreateGameRequest) GetOpen() bool {
+	if x != nil {
+		return x.Open
+	}
+	return false
+}
+
+func (x *CreateGameRequest) GetConfig() *GameConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type CreateGameResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	SeatTokens    []string               `protobuf:"bytes,2,rep,name=seat_tokens,json=seatTokens,proto3" json:"seat_tokens,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateGameResponse) Reset() {
+	*x = CreateGameResponse{}
+	mi := &file_proto_scout_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGameResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGameResponse) ProtoMessage() {}
+
+func (x *CreateGameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scout_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGameResponse.ProtoReflect.Descriptor instead.
+func (*CreateGameResponse) Descriptor() ([]byte, []int) {
+	return file_proto_scout_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CreateGameResponse) GetGameId() string {
 	if x != nil {
 		return x.GameId
 	}
 	return ""
 }
 
+func (x *CreateGameResponse) GetSeatTokens() []string {
+	if x != nil {
+		return x.SeatTokens
+	}
+	return nil
+}
+
 type PlayerActionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
 	PlayerIndex   int32                  `protobuf:"varint,2,opt,name=player_index,json=playerIndex,proto3" json:"player_index,omitempty"`
 	Action        *Action                `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`
+	StatusErrors  bool                   `protobuf:"varint,4,opt,name=status_errors,json=statusErrors,proto3" json:"status_errors,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *PlayerActionRequest) Reset() {
 	*x = PlayerActionRequest{}
-	mi := &file_proto_scout_proto_msgTypes[7]
+	mi := &file_proto_scout_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -578,7 +1076,7 @@ func (x *PlayerActionRequest) String() string {
 func (*PlayerActionRequest) ProtoMessage() {}
 
 func (x *PlayerActionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_scout_proto_msgTypes[7]
+	mi := &file_proto_scout_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -591,7 +1089,7 @@ func (x *PlayerActionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PlayerActionRequest.ProtoReflect.Descriptor instead.
 func (*PlayerActionRequest) Descriptor() ([]byte, []int) {
-	return file_proto_scout_proto_rawDescGZIP(), []int{7}
+	return file_proto_scout_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *PlayerActionRequest) GetGameId() string {
@@ -615,17 +1113,25 @@ func (x *PlayerActionRequest) GetAction() *Action {
 	return nil
 }
 
+func (x *PlayerActionRequest) GetStatusErrors() bool {
+	if x != nil {
+		return x.StatusErrors
+	}
+	return false
+}
+
 type PlayerActionResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Err           bool                   `protobuf:"varint,1,opt,name=err,proto3" json:"err,omitempty"`
 	ErrMsg        string                 `protobuf:"bytes,2,opt,name=errMsg,proto3" json:"errMsg,omitempty"`
+	ViolationCode ViolationCode          `protobuf:"varint,3,opt,name=violation_code,json=violationCode,proto3,enum=scout.ViolationCode" json:"violation_code,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *PlayerActionResponse) Reset() {
 	*x = PlayerActionResponse{}
-	mi := &file_proto_scout_proto_msgTypes[8]
+	mi := &file_proto_scout_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -637,7 +1143,7 @@ func (x *PlayerActionResponse) String() string {
 func (*PlayerActionResponse) ProtoMessage() {}
 
 func (x *PlayerActionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_scout_proto_msgTypes[8]
+	mi := &file_proto_scout_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -650,7 +1156,7 @@ func (x *PlayerActionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PlayerActionResponse.ProtoReflect.Descriptor instead.
 func (*PlayerActionResponse) Descriptor() ([]byte, []int) {
-	return file_proto_scout_proto_rawDescGZIP(), []int{8}
+	return file_proto_scout_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *PlayerActionResponse) GetErr() bool {
@@ -667,6 +1173,65 @@ func (x *PlayerActionResponse) GetErrMsg() string {
 	return ""
 }
 
+func (x *PlayerActionResponse) GetViolationCode() ViolationCode {
+	if x != nil {
+		return x.ViolationCode
+	}
+	return ViolationCode_ViolationUnknown
+}
+
+type RuleViolation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          ViolationCode          `protobuf:"varint,1,opt,name=code,proto3,enum=scout.ViolationCode" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RuleViolation) Reset() {
+	*x = RuleViolation{}
+	mi := &file_proto_scout_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RuleViolation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RuleViolation) ProtoMessage() {}
+
+func (x *RuleViolation) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scout_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RuleViolation.ProtoReflect.Descriptor instead.
+func (*RuleViolation) Descriptor() ([]byte, []int) {
+	return file_proto_scout_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *RuleViolation) GetCode() ViolationCode {
+	if x != nil {
+		return x.Code
+	}
+	return ViolationCode_ViolationUnknown
+}
+
+func (x *RuleViolation) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
 type GetGameStateRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
@@ -676,7 +1241,7 @@ type GetGameStateRequest struct {
 
 func (x *GetGameStateRequest) Reset() {
 	*x = GetGameStateRequest{}
-	mi := &file_proto_scout_proto_msgTypes[9]
+	mi := &file_proto_scout_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -688,7 +1253,7 @@ func (x *GetGameStateRequest) String() string {
 func (*GetGameStateRequest) ProtoMessage() {}
 
 func (x *GetGameStateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_scout_proto_msgTypes[9]
+	mi := &file_proto_scout_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -701,7 +1266,7 @@ func (x *GetGameStateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetGameStateRequest.ProtoReflect.Descriptor instead.
 func (*GetGameStateRequest) Descriptor() ([]byte, []int) {
-	return file_proto_scout_proto_rawDescGZIP(), []int{9}
+	return file_proto_scout_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *GetGameStateRequest) GetGameId() string {
@@ -720,7 +1285,7 @@ type GetGameStateResponse struct {
 
 func (x *GetGameStateResponse) Reset() {
 	*x = GetGameStateResponse{}
-	mi := &file_proto_scout_proto_msgTypes[10]
+	mi := &file_proto_scout_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -732,7 +1297,7 @@ func (x *GetGameStateResponse) String() string {
 func (*GetGameStateResponse) ProtoMessage() {}
 
 func (x *GetGameStateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_scout_proto_msgTypes[10]
+	mi := &file_proto_scout_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -745,7 +1310,7 @@ func (x *GetGameStateResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetGameStateResponse.ProtoReflect.Descriptor instead.
 func (*GetGameStateResponse) Descriptor() ([]byte, []int) {
-	return file_proto_scout_proto_rawDescGZIP(), []int{10}
+	return file_proto_scout_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *GetGameStateResponse) GetGame() *Game {
@@ -765,7 +1330,7 @@ type GetPlayerStateRequest struct {
 
 func (x *GetPlayerStateRequest) Reset() {
 	*x = GetPlayerStateRequest{}
-	mi := &file_proto_scout_proto_msgTypes[11]
+	mi := &file_proto_scout_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -777,7 +1342,7 @@ func (x *GetPlayerStateRequest) String() string {
 func (*GetPlayerStateRequest) ProtoMessage() {}
 
 func (x *GetPlayerStateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_scout_proto_msgTypes[11]
+	mi := &file_proto_scout_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -790,7 +1355,7 @@ func (x *GetPlayerStateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPlayerStateRequest.ProtoReflect.Descriptor instead.
 func (*GetPlayerStateRequest) Descriptor() ([]byte, []int) {
-	return file_proto_scout_proto_rawDescGZIP(), []int{11}
+	return file_proto_scout_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *GetPlayerStateRequest) GetGameId() string {
@@ -816,7 +1381,7 @@ type GetPlayerStateResponse struct {
 
 func (x *GetPlayerStateResponse) Reset() {
 	*x = GetPlayerStateResponse{}
-	mi := &file_proto_scout_proto_msgTypes[12]
+	mi := &file_proto_scout_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -828,7 +1393,7 @@ func (x *GetPlayerStateResponse) String() string {
 func (*GetPlayerStateResponse) ProtoMessage() {}
 
 func (x *GetPlayerStateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_scout_proto_msgTypes[12]
+	mi := &file_proto_scout_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -841,7 +1406,7 @@ func (x *GetPlayerStateResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPlayerStateResponse.ProtoReflect.Descriptor instead.
 func (*GetPlayerStateResponse) Descriptor() ([]byte, []int) {
-	return file_proto_scout_proto_rawDescGZIP(), []int{12}
+	return file_proto_scout_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *GetPlayerStateResponse) GetPlayer() *Player {
@@ -861,7 +1426,7 @@ type GetValidActionsRequest struct {
 
 func (x *GetValidActionsRequest) Reset() {
 	*x = GetValidActionsRequest{}
-	mi := &file_proto_scout_proto_msgTypes[13]
+	mi := &file_proto_scout_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -873,7 +1438,7 @@ func (x *GetValidActionsRequest) String() string {
 func (*GetValidActionsRequest) ProtoMessage() {}
 
 func (x *GetValidActionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_scout_proto_msgTypes[13]
+	mi := &file_proto_scout_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -886,7 +1451,7 @@ func (x *GetValidActionsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetValidActionsRequest.ProtoReflect.Descriptor instead.
 func (*GetValidActionsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_scout_proto_rawDescGZIP(), []int{13}
+	return file_proto_scout_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *GetValidActionsRequest) GetGameId() string {
@@ -912,7 +1477,7 @@ type GetValidActionsResponse struct {
 
 func (x *GetValidActionsResponse) Reset() {
 	*x = GetValidActionsResponse{}
-	mi := &file_proto_scout_proto_msgTypes[14]
+	mi := &file_proto_scout_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -924,7 +1489,7 @@ func (x *GetValidActionsResponse) String() string {
 func (*GetValidActionsResponse) ProtoMessage() {}
 
 func (x *GetValidActionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_scout_proto_msgTypes[14]
+	mi := &file_proto_scout_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -937,7 +1502,7 @@ func (x *GetValidActionsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetValidActionsResponse.ProtoReflect.Descriptor instead.
 func (*GetValidActionsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_scout_proto_rawDescGZIP(), []int{14}
+	return file_proto_scout_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *GetValidActionsResponse) GetMask() []bool {
@@ -947,73 +1512,2974 @@ func (x *GetValidActionsResponse) GetMask() []bool {
 	return nil
 }
 
-var File_proto_scout_proto protoreflect.FileDescriptor
+type DeterminizeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	PlayerIndex   int32                  `protobuf:"varint,2,opt,name=player_index,json=playerIndex,proto3" json:"player_index,omitempty"`
+	NumSamples    int32                  `protobuf:"varint,3,opt,name=num_samples,json=numSamples,proto3" json:"num_samples,omitempty"`
+	Seed          int64                  `protobuf:"varint,4,opt,name=seed,proto3" json:"seed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_proto_scout_proto_rawDesc = "" +
-	"\n" +
-	"\x11proto/scout.proto\x12\x05scout\"\x86\x03\n" +
-	"\x06Action\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x05R\x02id\x129\n" +
-	"\vaction_type\x18\x02 \x01(\x0e2\x18.scout.Action.ActionTypeR\n" +
-	"actionType\x12(\n" +
-	"\x10scout_take_index\x18\x03 \x01(\x05R\x0escoutTakeIndex\x12&\n" +
-	"\x0fscout_put_index\x18\x04 \x01(\x05R\rscoutPutIndex\x12(\n" +
-	"\x10show_first_index\x18\x05 \x01(\x05R\x0eshowFirstIndex\x12\x1f\n" +
-	"\vshow_length\x18\x06 \x01(\x05R\n" +
-	"showLength\"\x93\x01\n" +
-	"\n" +
-	"ActionType\x12\x0f\n" +
-	"\vActionScout\x10\x00\x12\x16\n" +
-	"\x12ActionScoutReverse\x10\x01\x12\x0e\n" +
-	"\n" +
-	"ActionShow\x10\x02\x12\x16\n" +
-	"\x12ActionScoutAndShow\x10\x03\x12\x1d\n" +
-	"\x19ActionScoutAndShowReverse\x10\x04\x12\x15\n" +
-	"\x11ActionReverseHand\x10\x05\"\xe4\x02\n" +
-	"\x04Game\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
-	"\vnum_players\x18\x02 \x01(\x05R\n" +
-	"numPlayers\x12.\n" +
-	"\x13active_player_index\x18\x03 \x01(\x05R\x11activePlayerIndex\x12*\n" +
-	"\n" +
-	"active_set\x18\x04 \x03(\v2\v.scout.CardR\tactiveSet\x125\n" +
-	"\x17active_set_player_index\x18\x05 \x01(\x05R\x14activeSetPlayerIndex\x12-\n" +
-	"\x12consecutive_scouts\x18\x06 \x01(\x05R\x11consecutiveScouts\x12\x14\n" +
-	"\x05round\x18\a \x01(\x05R\x05round\x12\x1a\n" +
-	"\bcomplete\x18\b \x01(\bR\bcomplete\x127\n" +
-	"\rplayer_states\x18\t \x03(\v2\x12.scout.PlayerStateR\fplayerStates\"\xc0\x01\n" +
-	"\x06Player\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
-	"\x05index\x18\x02 \x01(\x05R\x05index\x12\x14\n" +
-	"\x05score\x18\x03 \x01(\x05R\x05score\x12\x1f\n" +
-	"\x04hand\x18\x04 \x03(\v2\v.scout.CardR\x04hand\x12(\n" +
-	"\x10can_reverse_hand\x18\x05 \x01(\bR\x0ecanReverseHand\x12+\n" +
-	"\x12can_scout_and_show\x18\x06 \x01(\bR\x0fcanScoutAndShow\"6\n" +
-	"\x04Card\x12\x16\n" +
-	"\x06value1\x18\x01 \x01(\x05R\x06value1\x12\x16\n" +
-	"\x06value2\x18\x02 \x01(\x05R\x06value2\"c\n" +
-	"\vPlayerState\x12!\n" +
-	"\fplayer_index\x18\x01 \x01(\x05R\vplayerIndex\x12\x1b\n" +
-	"\thand_size\x18\x02 \x01(\x05R\bhandSize\x12\x14\n" +
-	"\x05score\x18\x03 \x01(\x05R\x05score\"4\n" +
-	"\x11CreateGameRequest\x12\x1f\n" +
-	"\vnum_players\x18\x01 \x01(\x05R\n" +
-	"numPlayers\"-\n" +
-	"\x12CreateGameResponse\x12\x17\n" +
-	"\agame_id\x18\x01 \x01(\tR\x06gameId\"x\n" +
-	"\x13PlayerActionRequest\x12\x17\n" +
-	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12!\n" +
-	"\fplayer_index\x18\x02 \x01(\x05R\vplayerIndex\x12%\n" +
-	"\x06action\x18\x03 \x01(\v2\r.scout.ActionR\x06action\"@\n" +
-	"\x14PlayerActionResponse\x12\x10\n" +
-	"\x03err\x18\x01 \x01(\bR\x03err\x12\x16\n" +
-	"\x06errMsg\x18\x02 \x01(\tR\x06errMsg\".\n" +
-	"\x13GetGameStateRequest\x12\x17\n" +
-	"\agame_id\x18\x01 \x01(\tR\x06gameId\"7\n" +
-	"\x14GetGameStateResponse\x12\x1f\n" +
-	"\x04game\x18\x01 \x01(\v2\v.scout.GameR\x04game\"S\n" +
-	"\x15GetPlayerStateRequest\x12\x17\n" +
-	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12!\n" +
+func (x *DeterminizeRequest) Reset() {
+	*x = DeterminizeRequest{}
+	mi := &file_proto_scout_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeterminizeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeterminizeRequest) ProtoMessage() {}
+
+func (x *DeterminizeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scout_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeterminizeRequest.ProtoReflect.Descriptor instead.
+func (*DeterminizeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_scout_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *DeterminizeRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *DeterminizeRequest) GetPlayerIndex() int32 {
+	if x != nil {
+		return x.PlayerIndex
+	}
+	return 0
+}
+
+func (x *DeterminizeRequest) GetNumSamples() int32 {
+	if x != nil {
+		return x.NumSamples
+	}
+	return 0
+}
+
+func (x *DeterminizeRequest) GetSeed() int64 {
+	if x != nil {
+		return x.Seed
+	}
+	return 0
+}
+
+type Determinization struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Game          *Game                  `protobuf:"bytes,1,opt,name=game,proto3" json:"game,omitempty"`
+	Players       []*Player              `protobuf:"bytes,2,rep,name=players,proto3" json:"players,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Determinization) Reset() {
+	*x = Determinization{}
+	mi := &file_proto_scout_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Determinization) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Determinization) ProtoMessage() {}
+
+func (x *Determinization) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scout_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Determinization.ProtoReflect.Descriptor instead.
+func (*Determinization) Descriptor() ([]byte, []int) {
+	return file_proto_scout_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *Determinization) GetGame() *Game {
+	if x != nil {
+		return x.Game
+	}
+	return nil
+}
+
+func (x *Determinization) GetPlayers() []*Player {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+type DeterminizeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Samples       []*Determinization     `protobuf:"bytes,1,rep,name=samples,proto3" json:"samples,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeterminizeResponse) Reset() {
+	*x = DeterminizeResponse{}
+	mi := &file_proto_scout_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeterminizeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeterminizeResponse) ProtoMessage() {}
+
+func (x *DeterminizeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scout_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeterminizeResponse.ProtoReflect.Descriptor instead.
+func (*DeterminizeResponse) Descriptor() ([]byte, []int) {
+	return file_proto_scout_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *DeterminizeResponse) GetSamples() []*Determinization {
+	if x != nil {
+		return x.Samples
+	}
+	return nil
+}
+
+type RegisterAgentRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	GameId         string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	PlayerIndex    int32                  `protobuf:"varint,2,opt,name=player_index,json=playerIndex,proto3" json:"player_index,omitempty"`
+	Address        string                 `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	TimeoutMs      int32                  `protobuf:"varint,4,opt,name=timeout_ms,json=timeoutMs,proto3" json:"timeout_ms,omitempty"`
+	FallbackPolicy string                 `protobuf:"bytes,5,opt,name=fallback_policy,json=fallbackPolicy,proto3" json:"fallback_policy,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RegisterAgentRequest) Reset() {
+	*x = RegisterAgentRequest{}
+	mi := &file_proto_scout_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterAgentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterAgentRequest) ProtoMessage() {}
+
+func (x *RegisterAgentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scout_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterAgentRequest.ProtoReflect.Descriptor instead.
+func (*RegisterAgentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_scout_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *RegisterAgentRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *RegisterAgentRequest) GetPlayerIndex() int32 {
+	if x != nil {
+		return x.PlayerIndex
+	}
+	return 0
+}
+
+func (x *RegisterAgentRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *RegisterAgentRequest) GetTimeoutMs() int32 {
+	if x != nil {
+		return x.TimeoutMs
+	}
+	return 0
+}
+
+func (x *RegisterAgentRequest) GetFallbackPolicy() string {
+	if x != nil {
+		return x.FallbackPolicy
+	}
+	return ""
+}
+
+type RegisterAgentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SeatToken     string                 `protobuf:"bytes,1,opt,name=seat_token,json=seatToken,proto3" json:"seat_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterAgentResponse) Reset() {
+	*x = RegisterAgentResponse{}
+	mi := &file_proto_scout_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterAgentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterAgentResponse) ProtoMessage() {}
+
+func (x *RegisterAgentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scout_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterAgentResponse.ProtoReflect.Descriptor instead.
+func (*RegisterAgentResponse) Descriptor() ([]byte, []int) {
+	return file_proto_scout_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *RegisterAgentResponse) GetSeatToken() string {
+	if x != nil {
+		return x.SeatToken
+	}
+	return ""
+}
+
+type Observation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Game          *Game                  `protobuf:"bytes,1,opt,name=game,proto3" json:"game,omitempty"`
+	Player        *Player                `protobuf:"bytes,2,opt,name=player,proto3" json:"player,omitempty"`
+	Tensor        []float32              `protobuf:"fixed32,3,rep,packed,name=tensor,proto3" json:"tensor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Observation) Reset() {
+	*x = Observation{}
+	mi := &file_proto_scout_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Observation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Observation) ProtoMessage() {}
+
+func (x *Observation) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scout_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Observation.ProtoReflect.Descriptor instead.
+func (*Observation) Descriptor() ([]byte, []int) {
+	return file_proto_scout_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *Observation) GetGame() *Game {
+	if x != nil {
+		return x.Game
+	}
+	return nil
+}
+
+func (x *Observation) GetPlayer() *Player {
+	if x != nil {
+		return x.Player
+	}
+	return nil
+}
+
+func (x *Observation) GetTensor() []float32 {
+	if x != nil {
+		return x.Tensor
+	}
+	return nil
+}
+
+type ChooseActionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	PlayerIndex   int32                  `protobuf:"varint,2,opt,name=player_index,json=playerIndex,proto3" json:"player_index,omitempty"`
+	Observation   *Observation           `protobuf:"bytes,3,opt,name=observation,proto3" json:"observation,omitempty"`
+	Mask          []bool                 `protobuf:"varint,4,rep,packed,name=mask,proto3" json:"mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChooseActionRequest) Reset() {
+	*x = ChooseActionRequest{}
+	mi := &file_proto_scout_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChooseActionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChooseActionRequest) ProtoMessage() {}
+
+func (x *ChooseActionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scout_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChooseActionRequest.ProtoReflect.Descriptor instead.
+func (*ChooseActionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_scout_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ChooseActionRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *ChooseActionRequest) GetPlayerIndex() int32 {
+	if x != nil {
+		return x.PlayerIndex
+	}
+	return 0
+}
+
+func (x *ChooseActionRequest) GetObservation() *Observation {
+	if x != nil {
+		return x.Observation
+	}
+	return nil
+}
+
+func (x *ChooseActionRequest) GetMask() []bool {
+	if x != nil {
+		return x.Mask
+	}
+	return nil
+}
+
+type ChooseActionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ActionId      int32                  `protobuf:"varint,1,opt,name=action_id,json=actionId,proto3" json:"action_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChooseActionResponse) Reset() {
+	*x = ChooseActionResponse{}
+	mi := &file_proto_scout_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChooseActionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChooseActionResponse) ProtoMessage() {}
+
+func (x *ChooseActionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scout_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChooseActionResponse.ProtoReflect.Descriptor instead.
+func (*ChooseActionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_scout_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ChooseActionResponse) GetActionId() int32 {
+	if x != nil {
+		return x.ActionId
+	}
+	return 0
+}
+
+type WatchGameRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	PlayerIndex   int32                  `protobuf:"varint,2,opt,name=player_index,json=playerIndex,proto3" json:"player_index,omitempty"`
+	Spectator     bool                   `protobuf:"varint,3,opt,name=spectator,proto3" json:"spectator,omitempty"`
+	Visibility    Visibility             `protobuf:"varint,4,opt,name=visibility,proto3,enum=scout.Visibility" json:"visibility,omitempty"`
+	Delay         int32                  `protobuf:"varint,5,opt,name=delay,proto3" json:"delay,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchGameRequest) Reset() {
+	*x = WatchGameRequest{}
+	mi := &file_proto_scout_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchGameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchGameRequest) ProtoMessage() {}
+
+func (x *WatchGameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scout_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchGameRequest.ProtoReflect.Descriptor instead.
+func (*WatchGameRequest) Descriptor() ([]byte, []int) {
+	return file_proto_scout_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *WatchGameRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *WatchGameRequest) GetPlayerIndex() int32 {
+	if x != nil {
+		return x.PlayerIndex
+	}
+	return 0
+}
+
+func (x *WatchGameRequest) GetSpectator() bool {
+	if x != nil {
+		return x.Spectator
+	}
+	return false
+}
+
+func (x *WatchGameRequest) GetVisibility() Visibility {
+	if x != nil {
+		return x.Visibility
+	}
+	return Visibility_VisibilityPublic
+}
+
+func (x *WatchGameRequest) GetDelay() int32 {
+	if x != nil {
+		return x.Delay
+	}
+	return 0
+}
+
+type GameEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventType     GameEvent_EventType    `protobuf:"varint,1,opt,name=event_type,json=eventType,proto3,enum=scout.GameEvent_EventType" json:"event_type,omitempty"`
+	Sequence      int64                  `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	PlayerIndex   int32                  `protobuf:"varint,3,opt,name=player_index,json=playerIndex,proto3" json:"player_index,omitempty"`
+	Action        *Action                `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"`
+	ScoutedCard   *Card                  `protobuf:"bytes,5,opt,name=scouted_card,json=scoutedCard,proto3" json:"scouted_card,omitempty"`
+	ShownCards    []*Card                `protobuf:"bytes,6,rep,name=shown_cards,json=shownCards,proto3" json:"shown_cards,omitempty"`
+	ScoreDelta    int32                  `protobuf:"varint,7,opt,name=score_delta,json=scoreDelta,proto3" json:"score_delta,omitempty"`
+	Score         int32                  `protobuf:"varint,8,opt,name=score,proto3" json:"score,omitempty"`
+	Round         int32                  `protobuf:"varint,9,opt,name=round,proto3" json:"round,omitempty"`
+	Game          *Game                  `protobuf:"bytes,10,opt,name=game,proto3" json:"game,omitempty"`
+	Player        *Player                `protobuf:"bytes,11,opt,name=player,proto3" json:"player,omitempty"`
+	Players       []*Player              `protobuf:"bytes,12,rep,name=players,proto3" json:"players,omitempty"`
+	Timeout       bool                   `protobuf:"varint,13,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GameEvent) Reset() {
+	*x = GameEvent{}
+	mi := &file_proto_scout_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GameEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameEvent) ProtoMessage() {}
+
+func (x *GameEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scout_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameEvent.ProtoReflect.Descriptor instead.
+func (*GameEvent) Descriptor() ([]byte, []int) {
+	return file_proto_scout_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GameEvent) GetEventType() GameEvent_EventType {
+	if x != nil {
+		return x.EventType
+	}
+	return GameEvent_EventActionApplied
+}
+
+func (x *GameEvent) GetSequence() int64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *GameEvent) GetPlayerIndex() int32 {
+	if x != nil {
+		return x.PlayerIndex
+	}
+	return 0
+}
+
+func (x *GameEvent) GetAction() *Action {
+	if x != nil {
+		return x.Action
+	}
+	return nil
+}
+
+func (x *GameEvent) GetScoutedCard() *Card {
+	if x != nil {
+		return x.ScoutedCard
+	}
+	return nil
+}
+
+func (x *GameEvent) GetShownCards() []*Card {
+	if x != nil {
+		return x.ShownCards
+	}
+	return nil
+}
+
+func (x *GameEvent) GetScoreDelta() int32 {
+	if x != nil {
+		return x.ScoreDelta
+	}
+	return 0
+}
+
+func (x *GameEvent) GetScore() int32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *GameEvent) GetRound() int32 {
+	if x != nil {
+		return x.Round
+	}
+	return 0
+}
+
+func (x *GameEvent) GetGame() *Game {
+	if x != nil {
+		return x.Game
+	}
+	return nil
+}
+
+func (x *GameEvent) GetPlayer() *Player {
+	if x != nil {
+		return x.Player
+	}
+	return nil
+}
+
+func (x *GameEvent) GetPlayers() []*Player {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+func (x *GameEvent) GetTimeout() bool {
+	if x != nil {
+		return x.Timeout
+	}
+	return false
+}
+
+type PlaySessionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Request:
+	//
+	//	*PlaySessionRequest_Join
+	//	*PlaySessionRequest_Action
+	Request       isPlaySessionRequest_Request `protobuf_oneof:"request"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlaySessionRequest) Reset() {
+	*x = PlaySessionRequest{}
+	mi := &file_proto_scout_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlaySessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlaySessionRequest) ProtoMessage() {}
+
+func (x *PlaySessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scout_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlaySessionRequest.ProtoReflect.Descriptor instead.
+func (*PlaySessionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_scout_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *PlaySessionRequest) GetRequest() isPlaySessionRequest_Request {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+func (x *PlaySessionRequest) GetJoin() *JoinSeat {
+	if x != nil {
+		if x, ok := x.Request.(*PlaySessionRequest_Join); ok {
+			return x.Join
+		}
+	}
+	return nil
+}
+
+func (x *PlaySessionRequest) GetAction() *Action {
+	if x != nil {
+		if x, ok := x.Request.(*PlaySessionRequest_Action); ok {
+			return x.Action
+		}
+	}
+	return nil
+}
+
+type isPlaySessionRequest_Request interface {
+	isPlaySessionRequest_Request()
+}
+
+type PlaySessionRequest_Join struct {
+	Join *JoinSeat `protobuf:"bytes,1,opt,name=join,proto3,oneof"`
+}
+
+type PlaySessionRequest_Action struct {
+	Action *Action `protobuf:"bytes,2,opt,name=action,proto3,oneof"`
+}
+
+func (*PlaySessionRequest_Join) isPlaySessionRequest_Request() {}
+
+func (*PlaySessionRequest_Action) isPlaySessionRequest_Request() {}
+
+type JoinSeat struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	PlayerIndex   int32                  `protobuf:"varint,2,opt,name=player_index,json=playerIndex,proto3" json:"player_index,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JoinSeat) Reset() {
+	*x = JoinSeat{}
+	mi := &file_proto_scout_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinSeat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinSeat) ProtoMessage() {}
+
+func (x *JoinSeat) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scout_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinSeat.ProtoReflect.Descriptor instead.
+func (*JoinSeat) Descriptor() ([]byte, []int) {
+	return file_proto_scout_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *JoinSeat) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *JoinSeat) GetPlayerIndex() int32 {
+	if x != nil {
+		return x.PlayerIndex
+	}
+	return 0
+}
+
+type PlaySessionResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Response:
+	//
+	//	*PlaySessionResponse_Joined
+	//	*PlaySessionResponse_Prompt
+	//	*PlaySessionResponse_Result
+	//	*PlaySessionResponse_Event
+	Response      isPlaySessionResponse_Response `protobuf_oneof:"response"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlaySessionResponse) Reset() {
+	*x = PlaySessionResponse{}
+	mi := &file_proto_scout_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlaySessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlaySessionResponse) ProtoMessage() {}
+
+func (x *PlaySessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scout_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlaySessionResponse.ProtoReflect.Descriptor instead.
+func (*PlaySessionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_scout_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *PlaySessionResponse) GetResponse() isPlaySessionResponse_Response {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *PlaySessionResponse) GetJoined() *Player {
+	if x != nil {
+		if x, ok := x.Response.(*PlaySessionResponse_Joined); ok {
+			return x.Joined
+		}
+	}
+	return nil
+}
+
+func (x *PlaySessionResponse) GetPrompt() *Prompt {
+	if x != nil {
+		if x, ok := x.Response.(*PlaySessionResponse_Prompt); ok {
+			return x.Prompt
+		}
+	}
+	return nil
+}
+
+func (x *PlaySessionResponse) GetResult() *PlayerActionResponse {
+	if x != nil {
+		if x, ok := x.Response.(*PlaySessionResponse_Result); ok {
+			return x.Result
+		}
+	}
+	return nil
+}
+
+func (x *PlaySessionResponse) GetEvent() *GameEvent {
+	if x != nil {
+		if x, ok := x.Response.(*PlaySessionResponse_Event); ok {
+			return x.Event
+		}
+	}
+	return nil
+}
+
+type isPlaySessionResponse_Response interface {
+	isPlaySessionResponse_Response()
+}
+
+type PlaySessionResponse_Joined struct {
+	Joined *Player `protobuf:"bytes,1,opt,name=joined,proto3,oneof"`
+}
+
+type PlaySessionResponse_Prompt struct {
+	Prompt *Prompt `protobuf:"bytes,2,opt,name=prompt,proto3,oneof"`
+}
+
+type PlaySessionResponse_Result struct {
+	Result *PlayerActionResponse `protobuf:"bytes,3,opt,name=result,proto3,oneof"`
+}
+
+type PlaySessionResponse_Event struct {
+	Event *GameEvent `protobuf:"bytes,4,opt,name=event,proto3,oneof"`
+}
+
+func (*PlaySessionResponse_Joined) isPlaySessionResponse_Response() {}
+
+func (*PlaySessionResponse_Prompt) isPlaySessionResponse_Response() {}
+
+func (*PlaySessionResponse_Result) isPlaySessionResponse_Response() {}
+
+func (*PlaySessionResponse_Event) isPlaySessionResponse_Response() {}
+
+type Prompt struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Observation   *Observation           `protobuf:"bytes,1,opt,name=observation,proto3" json:"observation,omitempty"`
+	Mask          []bool                 `protobuf:"varint,2,rep,packed,name=mask,proto3" json:"mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Prompt) Reset() {
+	*x = Prompt{}
+	mi := &file_proto_scout_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Prompt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Prompt) ProtoMessage() {}
+
+func (x *Prompt) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scout_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Prompt.ProtoReflect.Descriptor instead.
+func (*Prompt) Descriptor() ([]byte, []int) {
+	return file_proto_scout_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *Prompt) GetObservation() *Observation {
+	if x != nil {
+		return x.Observation
+	}
+	return nil
+}
+
+func (x *Prompt) GetMask() []bool {
+	if x != nil {
+		return x.Mask
+	}
+	return nil
+}
+
+type ListOpenGamesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOpenGamesRequest) Reset() {
+	*x = ListOpenGamesRequest{}
+	mi := &file_proto_scout_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOpenGamesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOpenGamesRequest) ProtoMessage() {}
+
+func (x *ListOpenGamesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scout_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOpenGamesRequest.ProtoReflect.Descriptor instead.
+func (*ListOpenGamesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_scout_proto_rawDescGZIP(), []int{32}
+}
+
+type ListOpenGamesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Games         []*Game                `protobuf:"bytes,1,rep,name=games,proto3" json:"games,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOpenGamesResponse) Reset() {
+	*x = ListOpenGamesResponse{}
+	mi := &file_proto_scout_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOpenGamesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOpenGamesResponse) ProtoMessage() {}
+
+func (x *ListOpenGamesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scout_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOpenGamesResponse.ProtoReflect.Descriptor instead.
+func (*ListOpenGamesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_scout_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ListOpenGamesResponse) GetGames() []*Game {
+	if x != nil {
+		return x.Games
+	}
+	return nil
+}
+
+type JoinGameRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JoinGameRequest) Reset() {
+	*x = JoinGameRequest{}
+	mi := &file_proto_scout_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinGameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinGameRequest) ProtoMessage() {}
+
+func (x *JoinGameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scout_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinGameRequest.ProtoReflect.Descriptor instead.
+func (*JoinGameRequest) Descriptor() ([]byte, []int) {
+	return file_proto_scout_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *JoinGameRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *JoinGameRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type JoinGameResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerIndex   int32                  `protobuf:"varint,1,opt,name=player_index,json=playerIndex,proto3" json:"player_index,omitempty"`
+	SeatToken     string                 `protobuf:"bytes,2,opt,name=seat_token,json=seatToken,proto3" json:"seat_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JoinGameResponse) Reset() {
+	*x = JoinGameResponse{}
+	mi := &file_proto_scout_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinGameResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinGameResponse) ProtoMessage() {}
+
+func (x *JoinGameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scout_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinGameResponse.ProtoReflect.Descriptor instead.
+func (*JoinGameResponse) Descriptor() ([]byte, []int) {
+	return file_proto_scout_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *JoinGameResponse) GetPlayerIndex() int32 {
+	if x != nil {
+		return x.PlayerIndex
+	}
+	return 0
+}
+
+func (x *JoinGameResponse) GetSeatToken() string {
+	if x != nil {
+		return x.SeatToken
+	}
+	return ""
+}
+
+type LeaveGameRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	PlayerIndex   int32                  `protobuf:"varint,2,opt,name=player_index,json=playerIndex,proto3" json:"player_index,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeaveGameRequest) Reset() {
+	*x = LeaveGameRequest{}
+	mi := &file_proto_scout_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaveGameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveGameRequest) ProtoMessage() {}
+
+func (x *LeaveGameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scout_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaveGameRequest.ProtoReflect.Descriptor instead.
+func (*LeaveGameRequest) Descriptor() ([]byte, []int) {
+	return file_proto_scout_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *LeaveGameRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *LeaveGameRequest) GetPlayerIndex() int32 {
+	if x != nil {
+		return x.PlayerIndex
+	}
+	return 0
+}
+
+type LeaveGameResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeaveGameResponse) Reset() {
+	*x = LeaveGameResponse{}
+	mi := &file_proto_scout_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaveGameResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveGameResponse) ProtoMessage() {}
+
+func (x *LeaveGameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scout_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaveGameResponse.ProtoReflect.Descriptor instead.
+func (*LeaveGameResponse) Descriptor() ([]byte, []int) {
+	return file_proto_scout_proto_rawDescGZIP(), []int{37}
+}
+
+type SetReadyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	PlayerIndex   int32                  `protobuf:"varint,2,opt,name=player_index,json=playerIndex,proto3" json:"player_index,omitempty"`
+	Ready         bool                   `protobuf:"varint,3,opt,name=ready,proto3" json:"ready,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetReadyRequest) Reset() {
+	*x = SetReadyRequest{}
+	mi := &file_proto_scout_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetReadyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetReadyRequest) ProtoMessage() {}
+
+func (x *SetReadyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scout_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetReadyRequest.ProtoReflect.Descriptor instead.
+func (*SetReadyRequest) Descriptor() ([]byte, []int) {
+	return file_proto_scout_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *SetReadyRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *SetReadyRequest) GetPlayerIndex() int32 {
+	if x != nil {
+		return x.PlayerIndex
+	}
+	return 0
+}
+
+func (x *SetReadyRequest) GetReady() bool {
+	if x != nil {
+		return x.Ready
+	}
+	return false
+}
+
+type SetReadyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Started       bool                   `protobuf:"varint,1,opt,name=started,proto3" json:"started,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetReadyResponse) Reset() {
+	*x = SetReadyResponse{}
+	mi := &file_proto_scout_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetReadyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetReadyResponse) ProtoMessage() {}
+
+func (x *SetReadyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scout_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetReadyResponse.ProtoReflect.Descriptor instead.
+func (*SetReadyResponse) Descriptor() ([]byte, []int) {
+	return file_proto_scout_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *SetReadyResponse) GetStarted() bool {
+	if x != nil {
+		return x.Started
+	}
+	return false
+}
+
+type ListGamesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Phases        []GamePhase            `protobuf:"varint,1,rep,packed,name=phases,proto3,enum=scout.GamePhase" json:"phases,omitempty"`
+	MinAgeMs      int64                  `protobuf:"varint,2,opt,name=min_age_ms,json=minAgeMs,proto3" json:"min_age_ms,omitempty"`
+	MaxAgeMs      int64                  `protobuf:"varint,3,opt,name=max_age_ms,json=maxAgeMs,proto3" json:"max_age_ms,omitempty"`
+	NumPlayers    int32                  `protobuf:"varint,4,opt,name=num_players,json=numPlayers,proto3" json:"num_players,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGamesRequest) Reset() {
+	*x = ListGamesRequest{}
+	mi := &file_proto_scout_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGamesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGamesRequest) ProtoMessage() {}
+
+func (x *ListGamesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scout_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGamesRequest.ProtoReflect.Descriptor instead.
+func (*ListGamesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_scout_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ListGamesRequest) GetPhases() []GamePhase {
+	if x != nil {
+		return x.Phases
+	}
+	return nil
+}
+
+func (x *ListGamesRequest) GetMinAgeMs() int64 {
+	if x != nil {
+		return x.MinAgeMs
+	}
+	return 0
+}
+
+func (x *ListGamesRequest) GetMaxAgeMs() int64 {
+	if x != nil {
+		return x.MaxAgeMs
+	}
+	return 0
+}
+
+func (x *ListGamesRequest) GetNumPlayers() int32 {
+	if x != nil {
+		return x.NumPlayers
+	}
+	return 0
+}
+
+type ListGamesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Games         []*Game                `protobuf:"bytes,1,rep,name=games,proto3" json:"games,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGamesResponse) Reset() {
+	*x = ListGamesResponse{}
+	mi := &file_proto_scout_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGamesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGamesResponse) ProtoMessage() {}
+
+func (x *ListGamesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scout_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGamesResponse.ProtoReflect.Descriptor instead.
+func (*ListGamesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_scout_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ListGamesResponse) GetGames() []*Game {
+	if x != nil {
+		return x.Games
+	}
+	return nil
+}
+
+type DeleteGameRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteGameRequest) Reset() {
+	*x = DeleteGameRequest{}
+	mi := &file_proto_scout_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteGameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteGameRequest) ProtoMessage() {}
+
+func (x *DeleteGameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scout_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteGameRequest.ProtoReflect.Descriptor instead.
+func (*DeleteGameRequest) Descriptor() ([]byte, []int) {
+	return file_proto_scout_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *DeleteGameRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+type DeleteGameResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteGameResponse) Reset() {
+	*x = DeleteGameResponse{}
+	mi := &file_proto_scout_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteGameResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteGameResponse) ProtoMessage() {}
+
+func (x *DeleteGameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scout_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteGameResponse.ProtoReflect.Descriptor instead.
+func (*DeleteGameResponse) Descriptor() ([]byte, []int) {
+	return file_proto_scout_proto_rawDescGZIP(), []int{43}
+}
+
+type GameSnapshot struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Version              int32                  `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	Id                   string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	NumPlayers           int32                  `protobuf:"varint,3,opt,name=num_players,json=numPlayers,proto3" json:"num_players,omitempty"`
+	Players              []*PlayerSnapshot      `protobuf:"bytes,4,rep,name=players,proto3" json:"players,omitempty"`
+	ActivePlayerIndex    int32                  `protobuf:"varint,5,opt,name=active_player_index,json=activePlayerIndex,proto3" json:"active_player_index,omitempty"`
+	ActiveSet            []*CardSnapshot        `protobuf:"bytes,6,rep,name=active_set,json=activeSet,proto3" json:"active_set,omitempty"`
+	ActiveSetPlayerIndex int32                  `protobuf:"varint,7,opt,name=active_set_player_index,json=activeSetPlayerIndex,proto3" json:"active_set_player_index,omitempty"`
+	ConsecutiveScouts    int32                  `protobuf:"varint,8,opt,name=consecutive_scouts,json=consecutiveScouts,proto3" json:"consecutive_scouts,omitempty"`
+	Round                int32                  `protobuf:"varint,9,opt,name=round,proto3" json:"round,omitempty"`
+	Complete             bool                   `protobuf:"varint,10,opt,name=complete,proto3" json:"complete,omitempty"`
+	Lobby                bool                   `protobuf:"varint,11,opt,name=lobby,proto3" json:"lobby,omitempty"`
+	Seed                 int64                  `protobuf:"varint,12,opt,name=seed,proto3" json:"seed,omitempty"`
+	RngState             []byte                 `protobuf:"bytes,13,opt,name=rng_state,json=rngState,proto3" json:"rng_state,omitempty"`
+	Config               *GameConfig            `protobuf:"bytes,14,opt,name=config,proto3" json:"config,omitempty"`
+	History              []*Move                `protobuf:"bytes,15,rep,name=history,proto3" json:"history,omitempty"`
+	CreatedAtMs          int64                  `protobuf:"varint,16,opt,name=created_at_ms,json=createdAtMs,proto3" json:"created_at_ms,omitempty"`
+	UpdatedAtMs          int64                  `protobuf:"varint,17,opt,name=updated_at_ms,json=updatedAtMs,proto3" json:"updated_at_ms,omitempty"`
+	Start                *PositionSnapshot      `protobuf:"bytes,18,opt,name=start,proto3" json:"start,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *GameSnapshot) Reset() {
+	*x = GameSnapshot{}
+	mi := &file_proto_scout_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GameSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameSnapshot) ProtoMessage() {}
+
+func (x *GameSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scout_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameSnapshot.ProtoReflect.Descriptor instead.
+func (*GameSnapshot) Descriptor() ([]byte, []int) {
+	return file_proto_scout_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GameSnapshot) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *GameSnapshot) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GameSnapshot) GetNumPlayers() int32 {
+	if x != nil {
+		return x.NumPlayers
+	}
+	return 0
+}
+
+func (x *GameSnapshot) GetPlayers() []*PlayerSnapshot {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+func (x *GameSnapshot) GetActivePlayerIndex() int32 {
+	if x != nil {
+		return x.ActivePlayerIndex
+	}
+	return 0
+}
+
+func (x *GameSnapshot) GetActiveSet() []*CardSnapshot {
+	if x != nil {
+		return x.ActiveSet
+	}
+	return nil
+}
+
+func (x *GameSnapshot) GetActiveSetPlayerIndex() int32 {
+	if x != nil {
+		return x.ActiveSetPlayerIndex
+	}
+	return 0
+}
+
+func (x *GameSnapshot) GetConsecutiveScouts() int32 {
+	if x != nil {
+		return x.ConsecutiveScouts
+	}
+	return 0
+}
+
+func (x *GameSnapshot) GetRound() int32 {
+	if x != nil {
+		return x.Round
+	}
+	return 0
+}
+
+func (x *GameSnapshot) GetComplete() bool {
+	if x != nil {
+		return x.Complete
+	}
+	return false
+}
+
+func (x *GameSnapshot) GetLobby() bool {
+	if x != nil {
+		return x.Lobby
+	}
+	return false
+}
+
+func (x *GameSnapshot) GetSeed() int64 {
+	if x != nil {
+		return x.Seed
+	}
+	return 0
+}
+
+func (x *GameSnapshot) GetRngState() []byte {
+	if x != nil {
+		return x.RngState
+	}
+	return nil
+}
+
+func (x *GameSnapshot) GetConfig() *GameConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *GameSnapshot) GetHistory() []*Move {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+func (x *GameSnapshot) GetCreatedAtMs() int64 {
+	if x != nil {
+		return x.CreatedAtMs
+	}
+	return 0
+}
+
+func (x *GameSnapshot) GetUpdatedAtMs() int64 {
+	if x != nil {
+		return x.UpdatedAtMs
+	}
+	return 0
+}
+
+func (x *GameSnapshot) GetStart() *PositionSnapshot {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+type PositionSnapshot struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Seats                []*SeatSnapshot        `protobuf:"bytes,1,rep,name=seats,proto3" json:"seats,omitempty"`
+	ActiveSet            []*CardSnapshot        `protobuf:"bytes,2,rep,name=active_set,json=activeSet,proto3" json:"active_set,omitempty"`
+	ActiveSetPlayerIndex int32                  `protobuf:"varint,3,opt,name=active_set_player_index,json=activeSetPlayerIndex,proto3" json:"active_set_player_index,omitempty"`
+	ActivePlayerIndex    int32                  `protobuf:"varint,4,opt,name=active_player_index,json=activePlayerIndex,proto3" json:"active_player_index,omitempty"`
+	ConsecutiveScouts    int32                  `protobuf:"varint,5,opt,name=consecutive_scouts,json=consecutiveScouts,proto3" json:"consecutive_scouts,omitempty"`
+	Round                int32                  `protobuf:"varint,6,opt,name=round,proto3" json:"round,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *PositionSnapshot) Reset() {
+	*x = PositionSnapshot{}
+	mi := &file_proto_scout_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PositionSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PositionSnapshot) ProtoMessage() {}
+
+func (x *PositionSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scout_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PositionSnapshot.ProtoReflect.Descriptor instead.
+func (*PositionSnapshot) Descriptor() ([]byte, []int) {
+	return file_proto_scout_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *PositionSnapshot) GetSeats() []*SeatSnapshot {
+	if x != nil {
+		return x.Seats
+	}
+	return nil
+}
+
+func (x *PositionSnapshot) GetActiveSet() []*CardSnapshot {
+	if x != nil {
+		return x.ActiveSet
+	}
+	return nil
+}
+
+func (x *PositionSnapshot) GetActiveSetPlayerIndex() int32 {
+	if x != nil {
+		return x.ActiveSetPlayerIndex
+	}
+	return 0
+}
+
+func (x *PositionSnapshot) GetActivePlayerIndex() int32 {
+	if x != nil {
+		return x.ActivePlayerIndex
+	}
+	return 0
+}
+
+func (x *PositionSnapshot) GetConsecutiveScouts() int32 {
+	if x != nil {
+		return x.ConsecutiveScouts
+	}
+	return 0
+}
+
+func (x *PositionSnapshot) GetRound() int32 {
+	if x != nil {
+		return x.Round
+	}
+	return 0
+}
+
+type SeatSnapshot struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Hand            []*CardSnapshot        `protobuf:"bytes,1,rep,name=hand,proto3" json:"hand,omitempty"`
+	Score           int32                  `protobuf:"varint,2,opt,name=score,proto3" json:"score,omitempty"`
+	CanReverseHand  bool                   `protobuf:"varint,3,opt,name=can_reverse_hand,json=canReverseHand,proto3" json:"can_reverse_hand,omitempty"`
+	CanScoutAndShow bool                   `protobuf:"varint,4,opt,name=can_scout_and_show,json=canScoutAndShow,proto3" json:"can_scout_and_show,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SeatSnapshot) Reset() {
+	*x = SeatSnapshot{}
+	mi := &file_proto_scout_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SeatSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeatSnapshot) ProtoMessage() {}
+
+func (x *SeatSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scout_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeatSnapshot.ProtoReflect.Descriptor instead.
+func (*SeatSnapshot) Descriptor() ([]byte, []int) {
+	return file_proto_scout_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *SeatSnapshot) GetHand() []*CardSnapshot {
+	if x != nil {
+		return x.Hand
+	}
+	return nil
+}
+
+func (x *SeatSnapshot) GetScore() int32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *SeatSnapshot) GetCanReverseHand() bool {
+	if x != nil {
+		return x.CanReverseHand
+	}
+	return false
+}
+
+func (x *SeatSnapshot) GetCanScoutAndShow() bool {
+	if x != nil {
+		return x.CanScoutAndShow
+	}
+	return false
+}
+
+type PlayerSnapshot struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Name            string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Index           int32                  `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	Score           int32                  `protobuf:"varint,3,opt,name=score,proto3" json:"score,omitempty"`
+	Hand            []*CardSnapshot        `protobuf:"bytes,4,rep,name=hand,proto3" json:"hand,omitempty"`
+	CanReverseHand  bool                   `protobuf:"varint,5,opt,name=can_reverse_hand,json=canReverseHand,proto3" json:"can_reverse_hand,omitempty"`
+	CanScoutAndShow bool                   `protobuf:"varint,6,opt,name=can_scout_and_show,json=canScoutAndShow,proto3" json:"can_scout_and_show,omitempty"`
+	Seated          bool                   `protobuf:"varint,7,opt,name=seated,proto3" json:"seated,omitempty"`
+	Ready           bool                   `protobuf:"varint,8,opt,name=ready,proto3" json:"ready,omitempty"`
+	TimeRemainingMs int64                  `protobuf:"varint,9,opt,name=time_remaining_ms,json=timeRemainingMs,proto3" json:"time_remaining_ms,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *PlayerSnapshot) Reset() {
+	*x = PlayerSnapshot{}
+	mi := &file_proto_scout_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerSnapshot) ProtoMessage() {}
+
+func (x *PlayerSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scout_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerSnapshot.ProtoReflect.Descriptor instead.
+func (*PlayerSnapshot) Descriptor() ([]byte, []int) {
+	return file_proto_scout_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *PlayerSnapshot) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PlayerSnapshot) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *PlayerSnapshot) GetScore() int32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *PlayerSnapshot) GetHand() []*CardSnapshot {
+	if x != nil {
+		return x.Hand
+	}
+	return nil
+}
+
+func (x *PlayerSnapshot) GetCanReverseHand() bool {
+	if x != nil {
+		return x.CanReverseHand
+	}
+	return false
+}
+
+func (x *PlayerSnapshot) GetCanScoutAndShow() bool {
+	if x != nil {
+		return x.CanScoutAndShow
+	}
+	return false
+}
+
+func (x *PlayerSnapshot) GetSeated() bool {
+	if x != nil {
+		return x.Seated
+	}
+	return false
+}
+
+func (x *PlayerSnapshot) GetReady() bool {
+	if x != nil {
+		return x.Ready
+	}
+	return false
+}
+
+func (x *PlayerSnapshot) GetTimeRemainingMs() int64 {
+	if x != nil {
+		return x.TimeRemainingMs
+	}
+	return 0
+}
+
+type CardSnapshot struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value1        int32                  `protobuf:"varint,1,opt,name=value1,proto3" json:"value1,omitempty"`
+	Value2        int32                  `protobuf:"varint,2,opt,name=value2,proto3" json:"value2,omitempty"`
+	Public        bool                   `protobuf:"varint,3,opt,name=public,proto3" json:"public,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CardSnapshot) Reset() {
+	*x = CardSnapshot{}
+	mi := &file_proto_scout_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CardSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CardSnapshot) ProtoMessage() {}
+
+func (x *CardSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scout_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CardSnapshot.ProtoReflect.Descriptor instead.
+func (*CardSnapshot) Descriptor() ([]byte, []int) {
+	return file_proto_scout_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *CardSnapshot) GetValue1() int32 {
+	if x != nil {
+		return x.Value1
+	}
+	return 0
+}
+
+func (x *CardSnapshot) GetValue2() int32 {
+	if x != nil {
+		return x.Value2
+	}
+	return 0
+}
+
+func (x *CardSnapshot) GetPublic() bool {
+	if x != nil {
+		return x.Public
+	}
+	return false
+}
+
+type Move struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerIndex   int32                  `protobuf:"varint,1,opt,name=player_index,json=playerIndex,proto3" json:"player_index,omitempty"`
+	Action        *Action                `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
+	Timeout       bool                   `protobuf:"varint,3,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Move) Reset() {
+	*x = Move{}
+	mi := &file_proto_scout_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Move) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Move) ProtoMessage() {}
+
+func (x *Move) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scout_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Move.ProtoReflect.Descriptor instead.
+func (*Move) Descriptor() ([]byte, []int) {
+	return file_proto_scout_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *Move) GetPlayerIndex() int32 {
+	if x != nil {
+		return x.PlayerIndex
+	}
+	return 0
+}
+
+func (x *Move) GetAction() *Action {
+	if x != nil {
+		return x.Action
+	}
+	return nil
+}
+
+func (x *Move) GetTimeout() bool {
+	if x != nil {
+		return x.Timeout
+	}
+	return false
+}
+
+type ExportGameRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportGameRequest) Reset() {
+	*x = ExportGameRequest{}
+	mi := &file_proto_scout_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportGameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportGameRequest) ProtoMessage() {}
+
+func (x *ExportGameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scout_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportGameRequest.ProtoReflect.Descriptor instead.
+func (*ExportGameRequest) Descriptor() ([]byte, []int) {
+	return file_proto_scout_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ExportGameRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+type ExportGameResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Snapshot      *GameSnapshot          `protobuf:"bytes,1,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportGameResponse) Reset() {
+	*x = ExportGameResponse{}
+	mi := &file_proto_scout_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportGameResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportGameResponse) ProtoMessage() {}
+
+func (x *ExportGameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scout_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportGameResponse.ProtoReflect.Descriptor instead.
+func (*ExportGameResponse) Descriptor() ([]byte, []int) {
+	return file_proto_scout_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ExportGameResponse) GetSnapshot() *GameSnapshot {
+	if x != nil {
+		return x.Snapshot
+	}
+	return nil
+}
+
+type ImportGameRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Snapshot      *GameSnapshot          `protobuf:"bytes,1,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportGameRequest) Reset() {
+	*x = ImportGameRequest{}
+	mi := &file_proto_scout_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportGameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportGameRequest) ProtoMessage() {}
+
+func (x *ImportGameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scout_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportGameRequest.ProtoReflect.Descriptor instead.
+func (*ImportGameRequest) Descriptor() ([]byte, []int) {
+	return file_proto_scout_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ImportGameRequest) GetSnapshot() *GameSnapshot {
+	if x != nil {
+		return x.Snapshot
+	}
+	return nil
+}
+
+type ImportGameResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	SeatTokens    []string               `protobuf:"bytes,2,rep,name=seat_tokens,json=seatTokens,proto3" json:"seat_tokens,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportGameResponse) Reset() {
+	*x = ImportGameResponse{}
+	mi := &file_proto_scout_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportGameResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportGameResponse) ProtoMessage() {}
+
+func (x *ImportGameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scout_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportGameResponse.ProtoReflect.Descriptor instead.
+func (*ImportGameResponse) Descriptor() ([]byte, []int) {
+	return file_proto_scout_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ImportGameResponse) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *ImportGameResponse) GetSeatTokens() []string {
+	if x != nil {
+		return x.SeatTokens
+	}
+	return nil
+}
+
+type CreateGameFromStateRequest struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Seats                []*SeatState           `protobuf:"bytes,1,rep,name=seats,proto3" json:"seats,omitempty"`
+	ActiveSet            []*Card                `protobuf:"bytes,2,rep,name=active_set,json=activeSet,proto3" json:"active_set,omitempty"`
+	ActiveSetPlayerIndex int32                  `protobuf:"varint,3,opt,name=active_set_player_index,json=activeSetPlayerIndex,proto3" json:"active_set_player_index,omitempty"`
+	ActivePlayerIndex    int32                  `protobuf:"varint,4,opt,name=active_player_index,json=activePlayerIndex,proto3" json:"active_player_index,omitempty"`
+	ConsecutiveScouts    int32                  `protobuf:"varint,5,opt,name=consecutive_scouts,json=consecutiveScouts,proto3" json:"consecutive_scouts,omitempty"`
+	Round                int32                  `protobuf:"varint,6,opt,name=round,proto3" json:"round,omitempty"`
+	Seed                 int64                  `protobuf:"varint,7,opt,name=seed,proto3" json:"seed,omitempty"`
+	Config               *GameConfig            `protobuf:"bytes,8,opt,name=config,proto3" json:"config,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *CreateGameFromStateRequest) Reset() {
+	*x = CreateGameFromStateRequest{}
+	mi := &file_proto_scout_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGameFromStateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGameFromStateRequest) ProtoMessage() {}
+
+func (x *CreateGameFromStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scout_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGameFromStateRequest.ProtoReflect.Descriptor instead.
+func (*CreateGameFromStateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_scout_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *CreateGameFromStateRequest) GetSeats() []*SeatState {
+	if x != nil {
+		return x.Seats
+	}
+	return nil
+}
+
+func (x *CreateGameFromStateRequest) GetActiveSet() []*Card {
+	if x != nil {
+		return x.ActiveSet
+	}
+	return nil
+}
+
+func (x *CreateGameFromStateRequest) GetActiveSetPlayerIndex() int32 {
+	if x != nil {
+		return x.ActiveSetPlayerIndex
+	}
+	return 0
+}
+
+func (x *CreateGameFromStateRequest) GetActivePlayerIndex() int32 {
+	if x != nil {
+		return x.ActivePlayerIndex
+	}
+	return 0
+}
+
+func (x *CreateGameFromStateRequest) GetConsecutiveScouts() int32 {
+	if x != nil {
+		return x.ConsecutiveScouts
+	}
+	return 0
+}
+
+func (x *CreateGameFromStateRequest) GetRound() int32 {
+	if x != nil {
+		return x.Round
+	}
+	return 0
+}
+
+func (x *CreateGameFromStateRequest) GetSeed() int64 {
+	if x != nil {
+		return x.Seed
+	}
+	return 0
+}
+
+func (x *CreateGameFromStateRequest) GetConfig() *GameConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type SeatState struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Hand            []*Card                `protobuf:"bytes,1,rep,name=hand,proto3" json:"hand,omitempty"`
+	Score           int32                  `protobuf:"varint,2,opt,name=score,proto3" json:"score,omitempty"`
+	CanReverseHand  bool                   `protobuf:"varint,3,opt,name=can_reverse_hand,json=canReverseHand,proto3" json:"can_reverse_hand,omitempty"`
+	CanScoutAndShow bool                   `protobuf:"varint,4,opt,name=can_scout_and_show,json=canScoutAndShow,proto3" json:"can_scout_and_show,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SeatState) Reset() {
+	*x = SeatState{}
+	mi := &file_proto_scout_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SeatState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeatState) ProtoMessage() {}
+
+func (x *SeatState) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scout_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeatState.ProtoReflect.Descriptor instead.
+func (*SeatState) Descriptor() ([]byte, []int) {
+	return file_proto_scout_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *SeatState) GetHand() []*Card {
+	if x != nil {
+		return x.Hand
+	}
+	return nil
+}
+
+func (x *SeatState) GetScore() int32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *SeatState) GetCanReverseHand() bool {
+	if x != nil {
+		return x.CanReverseHand
+	}
+	return false
+}
+
+func (x *SeatState) GetCanScoutAndShow() bool {
+	if x != nil {
+		return x.CanScoutAndShow
+	}
+	return false
+}
+
+type CreateGameFromStateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	SeatTokens    []string               `protobuf:"bytes,2,rep,name=seat_tokens,json=seatTokens,proto3" json:"seat_tokens,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateGameFromStateResponse) Reset() {
+	*x = CreateGameFromStateResponse{}
+	mi := &file_proto_scout_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGameFromStateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGameFromStateResponse) ProtoMessage() {}
+
+func (x *CreateGameFromStateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scout_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGameFromStateResponse.ProtoReflect.Descriptor instead.
+func (*CreateGameFromStateResponse) Descriptor() ([]byte, []int) {
+	return file_proto_scout_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *CreateGameFromStateResponse) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *CreateGameFromStateResponse) GetSeatTokens() []string {
+	if x != nil {
+		return x.SeatTokens
+	}
+	return nil
+}
+
+type ExportGameRecordRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportGameRecordRequest) Reset() {
+	*x = ExportGameRecordRequest{}
+	mi := &file_proto_scout_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportGameRecordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportGameRecordRequest) ProtoMessage() {}
+
+func (x *ExportGameRecordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scout_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportGameRecordRequest.ProtoReflect.Descriptor instead.
+func (*ExportGameRecordRequest) Descriptor() ([]byte, []int) {
+	return file_proto_scout_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ExportGameRecordRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+type ExportGameRecordResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Record        string                 `protobuf:"bytes,1,opt,name=record,proto3" json:"record,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportGameRecordResponse) Reset() {
+	*x = ExportGameRecordResponse{}
+	mi := &file_proto_scout_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportGameRecordResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportGameRecordResponse) ProtoMessage() {}
+
+func (x *ExportGameRecordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scout_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportGameRecordResponse.ProtoReflect.Descriptor instead.
+func (*ExportGameRecordResponse) Descriptor() ([]byte, []int) {
+	return file_proto_scout_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ExportGameRecordResponse) GetRecord() string {
+	if x != nil {
+		return x.Record
+	}
+	return ""
+}
+
+type ImportGameRecordRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Record        string                 `protobuf:"bytes,1,opt,name=record,proto3" json:"record,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportGameRecordRequest) Reset() {
+	*x = ImportGameRecordRequest{}
+	mi := &file_proto_scout_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportGameRecordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportGameRecordRequest) ProtoMessage() {}
+
+func (x *ImportGameRecordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scout_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportGameRecordRequest.ProtoReflect.Descriptor instead.
+func (*ImportGameRecordRequest) Descriptor() ([]byte, []int) {
+	return file_proto_scout_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ImportGameRecordRequest) GetRecord() string {
+	if x != nil {
+		return x.Record
+	}
+	return ""
+}
+
+type ImportGameRecordResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	SeatTokens    []string               `protobuf:"bytes,2,rep,name=seat_tokens,json=seatTokens,proto3" json:"seat_tokens,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportGameRecordResponse) Reset() {
+	*x = ImportGameRecordResponse{}
+	mi := &file_proto_scout_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportGameRecordResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportGameRecordResponse) ProtoMessage() {}
+
+func (x *ImportGameRecordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scout_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportGameRecordResponse.ProtoReflect.Descriptor instead.
+func (*ImportGameRecordResponse) Descriptor() ([]byte, []int) {
+	return file_proto_scout_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ImportGameRecordResponse) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *ImportGameRecordResponse) GetSeatTokens() []string {
+	if x != nil {
+		return x.SeatTokens
+	}
+	return nil
+}
+
+type ExplainActionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	PlayerIndex   int32                  `protobuf:"varint,2,opt,name=player_index,json=playerIndex,proto3" json:"player_index,omitempty"`
+	Action        *Action                `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExplainActionRequest) Reset() {
+	*x = ExplainActionRequest{}
+	mi := &file_proto_scout_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExplainActionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExplainActionRequest) ProtoMessage() {}
+
+func (x *ExplainActionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scout_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExplainActionRequest.ProtoReflect.Descriptor instead.
+func (*ExplainActionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_scout_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *ExplainActionRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *ExplainActionRequest) GetPlayerIndex() int32 {
+	if x != nil {
+		return x.PlayerIndex
+	}
+	return 0
+}
+
+func (x *ExplainActionRequest) GetAction() *Action {
+	if x != nil {
+		return x.Action
+	}
+	return nil
+}
+
+type ExplainActionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Valid         bool                   `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	Problems      []*ActionProblem       `protobuf:"bytes,2,rep,name=problems,proto3" json:"problems,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExplainActionResponse) Reset() {
+	*x = ExplainActionResponse{}
+	mi := &file_proto_scout_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExplainActionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExplainActionResponse) ProtoMessage() {}
+
+func (x *ExplainActionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scout_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExplainActionResponse.ProtoReflect.Descriptor instead.
+func (*ExplainActionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_scout_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *ExplainActionResponse) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *ExplainActionResponse) GetProblems() []*ActionProblem {
+	if x != nil {
+		return x.Problems
+	}
+	return nil
+}
+
+type ActionProblem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          ViolationCode          `protobuf:"varint,1,opt,name=code,proto3,enum=scout.ViolationCode" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Cards         []*Card                `protobuf:"bytes,3,rep,name=cards,proto3" json:"cards,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActionProblem) Reset() {
+	*x = ActionProblem{}
+	mi := &file_proto_scout_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActionProblem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActionProblem) ProtoMessage() {}
+
+func (x *ActionProblem) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scout_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActionProblem.ProtoReflect.Descriptor instead.
+func (*ActionProblem) Descriptor() ([]byte, []int) {
+	return file_proto_scout_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *ActionProblem) GetCode() ViolationCode {
+	if x != nil {
+		return x.Code
+	}
+	return ViolationCode_ViolationUnknown
+}
+
+func (x *ActionProblem) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ActionProblem) GetCards() []*Card {
+	if x != nil {
+		return x.Cards
+	}
+	return nil
+}
+
+var File_proto_scout_proto protoreflect.FileDescriptor
+
+const file_proto_scout_proto_rawDesc = "" +
+	"\n" +
+	"\x11proto/scout.proto\x12\x05scout\"\x86\x03\n" +
+	"\x06Action\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x129\n" +
+	"\vaction_type\x18\x02 \x01(\x0e2\x18.scout.Action.ActionTypeR\n" +
+	"actionType\x12(\n" +
+	"\x10scout_take_index\x18\x03 \x01(\x05R\x0escoutTakeIndex\x12&\n" +
+	"\x0fscout_put_index\x18\x04 \x01(\x05R\rscoutPutIndex\x12(\n" +
+	"\x10show_first_index\x18\x05 \x01(\x05R\x0eshowFirstIndex\x12\x1f\n" +
+	"\vshow_length\x18\x06 \x01(\x05R\n" +
+	"showLength\"\x93\x01\n" +
+	"\n" +
+	"ActionType\x12\x0f\n" +
+	"\vActionScout\x10\x00\x12\x16\n" +
+	"\x12ActionScoutReverse\x10\x01\x12\x0e\n" +
+	"\n" +
+	"ActionShow\x10\x02\x12\x16\n" +
+	"\x12ActionScoutAndShow\x10\x03\x12\x1d\n" +
+	"\x19ActionScoutAndShowReverse\x10\x04\x12\x15\n" +
+	"\x11ActionReverseHand\x10\x05\"\xb4\x04\n" +
+	"\x04Game\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vnum_players\x18\x02 \x01(\x05R\n" +
+	"numPlayers\x12.\n" +
+	"\x13active_player_index\x18\x03 \x01(\x05R\x11activePlayerIndex\x12*\n" +
+	"\n" +
+	"active_set\x18\x04 \x03(\v2\v.scout.CardR\tactiveSet\x125\n" +
+	"\x17active_set_player_index\x18\x05 \x01(\x05R\x14activeSetPlayerIndex\x12-\n" +
+	"\x12consecutive_scouts\x18\x06 \x01(\x05R\x11consecutiveScouts\x12\x14\n" +
+	"\x05round\x18\a \x01(\x05R\x05round\x12\x1a\n" +
+	"\bcomplete\x18\b \x01(\bR\bcomplete\x127\n" +
+	"\rplayer_states\x18\t \x03(\v2\x12.scout.PlayerStateR\fplayerStates\x12&\n" +
+	"\x05phase\x18\n" +
+	" \x01(\x0e2\x10.scout.GamePhaseR\x05phase\x12)\n" +
+	"\x06config\x18\v \x01(\v2\x11.scout.GameConfigR\x06config\x123\n" +
+	"\x16turn_time_remaining_ms\x18\f \x01(\x03R\x13turnTimeRemainingMs\x12\"\n" +
+	"\rcreated_at_ms\x18\r \x01(\x03R\vcreatedAtMs\x12\"\n" +
+	"\rupdated_at_ms\x18\x0e \x01(\x03R\vupdatedAtMs\"\x94\x03\n" +
+	"\n" +
+	"GameConfig\x12I\n" +
+	"\x14spectator_visibility\x18\x01 \x01(\x0e2\x11.scout.VisibilityH\x00R\x13spectatorVisibility\x88\x01\x01\x12,\n" +
+	"\x0fspectator_delay\x18\x02 \x01(\x05H\x01R\x0espectatorDelay\x88\x01\x01\x12%\n" +
+	"\fturn_time_ms\x18\x03 \x01(\x03H\x02R\n" +
+	"turnTimeMs\x88\x01\x01\x12'\n" +
+	"\rtotal_time_ms\x18\x04 \x01(\x03H\x03R\vtotalTimeMs\x88\x01\x01\x12*\n" +
+	"\x0etimeout_policy\x18\x05 \x01(\tH\x04R\rtimeoutPolicy\x88\x01\x01\x12 \n" +
+	"\tdebug_log\x18\x06 \x01(\bH\x05R\bdebugLog\x88\x01\x01B\x17\n" +
+	"\x15_spectator_visibilityB\x12\n" +
+	"\x10_spectator_delayB\x0f\n" +
+	"\r_turn_time_msB\x10\n" +
+	"\x0e_total_time_msB\x11\n" +
+	"\x0f_timeout_policyB\f\n" +
+	"\n" +
+	"_debug_log\"\xc0\x01\n" +
+	"\x06Player\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05index\x18\x02 \x01(\x05R\x05index\x12\x14\n" +
+	"\x05score\x18\x03 \x01(\x05R\x05score\x12\x1f\n" +
+	"\x04hand\x18\x04 \x03(\v2\v.scout.CardR\x04hand\x12(\n" +
+	"\x10can_reverse_hand\x18\x05 \x01(\bR\x0ecanReverseHand\x12+\n" +
+	"\x12can_scout_and_show\x18\x06 \x01(\bR\x0fcanScoutAndShow\"6\n" +
+	"\x04Card\x12\x16\n" +
+	"\x06value1\x18\x01 \x01(\x05R\x06value1\x12\x16\n" +
+	"\x06value2\x18\x02 \x01(\x05R\x06value2\"\xa3\x02\n" +
+	"\vPlayerState\x12!\n" +
+	"\fplayer_index\x18\x01 \x01(\x05R\vplayerIndex\x12\x1b\n" +
+	"\thand_size\x18\x02 \x01(\x05R\bhandSize\x12\x14\n" +
+	"\x05score\x18\x03 \x01(\x05R\x05score\x124\n" +
+	"\fpublic_cards\x18\x04 \x03(\v2\x11.scout.PublicCardR\vpublicCards\x12\x12\n" +
+	"\x04name\x18\x05 \x01(\tR\x04name\x12\x16\n" +
+	"\x06seated\x18\x06 \x01(\bR\x06seated\x12\x14\n" +
+	"\x05ready\x18\a \x01(\bR\x05ready\x12*\n" +
+	"\x11time_remaining_ms\x18\b \x01(\x03R\x0ftimeRemainingMs\x12\x1a\n" +
+	"\btimeouts\x18\t \x01(\x05R\btimeouts\"I\n" +
+	"\n" +
+	"PublicCard\x12\x1a\n" +
+	"\bposition\x18\x01 \x01(\x05R\bposition\x12\x1f\n" +
+	"\x04card\x18\x02 \x01(\v2\v.scout.CardR\x04card\"s\n" +
+	"\x11CreateGameRequest\x12\x1f\n" +
+	"\vnum_players\x18\x01 \x01(\x05R\n" +
+	"numPlayers\x12\x12\n" +
+	"\x04open\x18\x02 \x01(\bR\x04open\x12)\n" +
+	"\x06config\x18\x03 \x01(\v2\x11.scout.GameConfigR\x06config\"N\n" +
+	"\x12CreateGameResponse\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12\x1f\n" +
+	"\vseat_tokens\x18\x02 \x03(\tR\n" +
+	"seatTokens\"\x9d\x01\n" +
+	"\x13PlayerActionRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12!\n" +
+	"\fplayer_index\x18\x02 \x01(\x05R\vplayerIndex\x12%\n" +
+	"\x06action\x18\x03 \x01(\v2\r.scout.ActionR\x06action\x12#\n" +
+	"\rstatus_errors\x18\x04 \x01(\bR\fstatusErrors\"}\n" +
+	"\x14PlayerActionResponse\x12\x10\n" +
+	"\x03err\x18\x01 \x01(\bR\x03err\x12\x16\n" +
+	"\x06errMsg\x18\x02 \x01(\tR\x06errMsg\x12;\n" +
+	"\x0eviolation_code\x18\x03 \x01(\x0e2\x14.scout.ViolationCodeR\rviolationCode\"S\n" +
+	"\rRuleViolation\x12(\n" +
+	"\x04code\x18\x01 \x01(\x0e2\x14.scout.ViolationCodeR\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\".\n" +
+	"\x13GetGameStateRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\"7\n" +
+	"\x14GetGameStateResponse\x12\x1f\n" +
+	"\x04game\x18\x01 \x01(\v2\v.scout.GameR\x04game\"S\n" +
+	"\x15GetPlayerStateRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12!\n" +
 	"\fplayer_index\x18\x02 \x01(\x05R\vplayerIndex\"?\n" +
 	"\x16GetPlayerStateResponse\x12%\n" +
 	"\x06player\x18\x01 \x01(\v2\r.scout.PlayerR\x06player\"T\n" +
@@ -1021,14 +4487,282 @@ const file_proto_scout_proto_rawDesc = "" +
 	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12!\n" +
 	"\fplayer_index\x18\x02 \x01(\x05R\vplayerIndex\"-\n" +
 	"\x17GetValidActionsResponse\x12\x12\n" +
-	"\x04mask\x18\x01 \x03(\bR\x04mask2\x84\x03\n" +
+	"\x04mask\x18\x01 \x03(\bR\x04mask\"\x85\x01\n" +
+	"\x12DeterminizeRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12!\n" +
+	"\fplayer_index\x18\x02 \x01(\x05R\vplayerIndex\x12\x1f\n" +
+	"\vnum_samples\x18\x03 \x01(\x05R\n" +
+	"numSamples\x12\x12\n" +
+	"\x04seed\x18\x04 \x01(\x03R\x04seed\"[\n" +
+	"\x0fDeterminization\x12\x1f\n" +
+	"\x04game\x18\x01 \x01(\v2\v.scout.GameR\x04game\x12'\n" +
+	"\aplayers\x18\x02 \x03(\v2\r.scout.PlayerR\aplayers\"G\n" +
+	"\x13DeterminizeResponse\x120\n" +
+	"\asamples\x18\x01 \x03(\v2\x16.scout.DeterminizationR\asamples\"\xb4\x01\n" +
+	"\x14RegisterAgentRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12!\n" +
+	"\fplayer_index\x18\x02 \x01(\x05R\vplayerIndex\x12\x18\n" +
+	"\aaddress\x18\x03 \x01(\tR\aaddress\x12\x1d\n" +
+	"\n" +
+	"timeout_ms\x18\x04 \x01(\x05R\ttimeoutMs\x12'\n" +
+	"\x0ffallback_policy\x18\x05 \x01(\tR\x0efallbackPolicy\"6\n" +
+	"\x15RegisterAgentResponse\x12\x1d\n" +
+	"\n" +
+	"seat_token\x18\x01 \x01(\tR\tseatToken\"m\n" +
+	"\vObservation\x12\x1f\n" +
+	"\x04game\x18\x01 \x01(\v2\v.scout.GameR\x04game\x12%\n" +
+	"\x06player\x18\x02 \x01(\v2\r.scout.PlayerR\x06player\x12\x16\n" +
+	"\x06tensor\x18\x03 \x03(\x02R\x06tensor\"\x9b\x01\n" +
+	"\x13ChooseActionRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12!\n" +
+	"\fplayer_index\x18\x02 \x01(\x05R\vplayerIndex\x124\n" +
+	"\vobservation\x18\x03 \x01(\v2\x12.scout.ObservationR\vobservation\x12\x12\n" +
+	"\x04mask\x18\x04 \x03(\bR\x04mask\"3\n" +
+	"\x14ChooseActionResponse\x12\x1b\n" +
+	"\taction_id\x18\x01 \x01(\x05R\bactionId\"\xb5\x01\n" +
+	"\x10WatchGameRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12!\n" +
+	"\fplayer_index\x18\x02 \x01(\x05R\vplayerIndex\x12\x1c\n" +
+	"\tspectator\x18\x03 \x01(\bR\tspectator\x121\n" +
+	"\n" +
+	"visibility\x18\x04 \x01(\x0e2\x11.scout.VisibilityR\n" +
+	"visibility\x12\x14\n" +
+	"\x05delay\x18\x05 \x01(\x05R\x05delay\"\xdd\x04\n" +
+	"\tGameEvent\x129\n" +
+	"\n" +
+	"event_type\x18\x01 \x01(\x0e2\x1a.scout.GameEvent.EventTypeR\teventType\x12\x1a\n" +
+	"\bsequence\x18\x02 \x01(\x03R\bsequence\x12!\n" +
+	"\fplayer_index\x18\x03 \x01(\x05R\vplayerIndex\x12%\n" +
+	"\x06action\x18\x04 \x01(\v2\r.scout.ActionR\x06action\x12.\n" +
+	"\fscouted_card\x18\x05 \x01(\v2\v.scout.CardR\vscoutedCard\x12,\n" +
+	"\vshown_cards\x18\x06 \x03(\v2\v.scout.CardR\n" +
+	"shownCards\x12\x1f\n" +
+	"\vscore_delta\x18\a \x01(\x05R\n" +
+	"scoreDelta\x12\x14\n" +
+	"\x05score\x18\b \x01(\x05R\x05score\x12\x14\n" +
+	"\x05round\x18\t \x01(\x05R\x05round\x12\x1f\n" +
+	"\x04game\x18\n" +
+	" \x01(\v2\v.scout.GameR\x04game\x12%\n" +
+	"\x06player\x18\v \x01(\v2\r.scout.PlayerR\x06player\x12'\n" +
+	"\aplayers\x18\f \x03(\v2\r.scout.PlayerR\aplayers\x12\x18\n" +
+	"\atimeout\x18\r \x01(\bR\atimeout\"y\n" +
+	"\tEventType\x12\x16\n" +
+	"\x12EventActionApplied\x10\x00\x12\x15\n" +
+	"\x11EventScoreChanged\x10\x01\x12\x13\n" +
+	"\x0fEventRoundEnded\x10\x02\x12\x12\n" +
+	"\x0eEventGameEnded\x10\x03\x12\x14\n" +
+	"\x10EventGameStarted\x10\x04\"o\n" +
+	"\x12PlaySessionRequest\x12%\n" +
+	"\x04join\x18\x01 \x01(\v2\x0f.scout.JoinSeatH\x00R\x04join\x12'\n" +
+	"\x06action\x18\x02 \x01(\v2\r.scout.ActionH\x00R\x06actionB\t\n" +
+	"\arequest\"F\n" +
+	"\bJoinSeat\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12!\n" +
+	"\fplayer_index\x18\x02 \x01(\x05R\vplayerIndex\"\xd4\x01\n" +
+	"\x13PlaySessionResponse\x12'\n" +
+	"\x06joined\x18\x01 \x01(\v2\r.scout.PlayerH\x00R\x06joined\x12'\n" +
+	"\x06prompt\x18\x02 \x01(\v2\r.scout.PromptH\x00R\x06prompt\x125\n" +
+	"\x06result\x18\x03 \x01(\v2\x1b.scout.PlayerActionResponseH\x00R\x06result\x12(\n" +
+	"\x05event\x18\x04 \x01(\v2\x10.scout.GameEventH\x00R\x05eventB\n" +
+	"\n" +
+	"\bresponse\"R\n" +
+	"\x06Prompt\x124\n" +
+	"\vobservation\x18\x01 \x01(\v2\x12.scout.ObservationR\vobservation\x12\x12\n" +
+	"\x04mask\x18\x02 \x03(\bR\x04mask\"\x16\n" +
+	"\x14ListOpenGamesRequest\":\n" +
+	"\x15ListOpenGamesResponse\x12!\n" +
+	"\x05games\x18\x01 \x03(\v2\v.scout.GameR\x05games\">\n" +
+	"\x0fJoinGameRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"T\n" +
+	"\x10JoinGameResponse\x12!\n" +
+	"\fplayer_index\x18\x01 \x01(\x05R\vplayerIndex\x12\x1d\n" +
+	"\n" +
+	"seat_token\x18\x02 \x01(\tR\tseatToken\"N\n" +
+	"\x10LeaveGameRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12!\n" +
+	"\fplayer_index\x18\x02 \x01(\x05R\vplayerIndex\"\x13\n" +
+	"\x11LeaveGameResponse\"c\n" +
+	"\x0fSetReadyRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12!\n" +
+	"\fplayer_index\x18\x02 \x01(\x05R\vplayerIndex\x12\x14\n" +
+	"\x05ready\x18\x03 \x01(\bR\x05ready\",\n" +
+	"\x10SetReadyResponse\x12\x18\n" +
+	"\astarted\x18\x01 \x01(\bR\astarted\"\x99\x01\n" +
+	"\x10ListGamesRequest\x12(\n" +
+	"\x06phases\x18\x01 \x03(\x0e2\x10.scout.GamePhaseR\x06phases\x12\x1c\n" +
+	"\n" +
+	"min_age_ms\x18\x02 \x01(\x03R\bminAgeMs\x12\x1c\n" +
+	"\n" +
+	"max_age_ms\x18\x03 \x01(\x03R\bmaxAgeMs\x12\x1f\n" +
+	"\vnum_players\x18\x04 \x01(\x05R\n" +
+	"numPlayers\"6\n" +
+	"\x11ListGamesResponse\x12!\n" +
+	"\x05games\x18\x01 \x03(\v2\v.scout.GameR\x05games\",\n" +
+	"\x11DeleteGameRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\"\x14\n" +
+	"\x12DeleteGameResponse\"\x96\x05\n" +
+	"\fGameSnapshot\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\x05R\aversion\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12\x1f\n" +
+	"\vnum_players\x18\x03 \x01(\x05R\n" +
+	"numPlayers\x12/\n" +
+	"\aplayers\x18\x04 \x03(\v2\x15.scout.PlayerSnapshotR\aplayers\x12.\n" +
+	"\x13active_player_index\x18\x05 \x01(\x05R\x11activePlayerIndex\x122\n" +
+	"\n" +
+	"active_set\x18\x06 \x03(\v2\x13.scout.CardSnapshotR\tactiveSet\x125\n" +
+	"\x17active_set_player_index\x18\a \x01(\x05R\x14activeSetPlayerIndex\x12-\n" +
+	"\x12consecutive_scouts\x18\b \x01(\x05R\x11consecutiveScouts\x12\x14\n" +
+	"\x05round\x18\t \x01(\x05R\x05round\x12\x1a\n" +
+	"\bcomplete\x18\n" +
+	" \x01(\bR\bcomplete\x12\x14\n" +
+	"\x05lobby\x18\v \x01(\bR\x05lobby\x12\x12\n" +
+	"\x04seed\x18\f \x01(\x03R\x04seed\x12\x1b\n" +
+	"\trng_state\x18\r \x01(\fR\brngState\x12)\n" +
+	"\x06config\x18\x0e \x01(\v2\x11.scout.GameConfigR\x06config\x12%\n" +
+	"\ahistory\x18\x0f \x03(\v2\v.scout.MoveR\ahistory\x12\"\n" +
+	"\rcreated_at_ms\x18\x10 \x01(\x03R\vcreatedAtMs\x12\"\n" +
+	"\rupdated_at_ms\x18\x11 \x01(\x03R\vupdatedAtMs\x12-\n" +
+	"\x05start\x18\x12 \x01(\v2\x17.scout.PositionSnapshotR\x05start\"\x9d\x02\n" +
+	"\x10PositionSnapshot\x12)\n" +
+	"\x05seats\x18\x01 \x03(\v2\x13.scout.SeatSnapshotR\x05seats\x122\n" +
+	"\n" +
+	"active_set\x18\x02 \x03(\v2\x13.scout.CardSnapshotR\tactiveSet\x125\n" +
+	"\x17active_set_player_index\x18\x03 \x01(\x05R\x14activeSetPlayerIndex\x12.\n" +
+	"\x13active_player_index\x18\x04 \x01(\x05R\x11activePlayerIndex\x12-\n" +
+	"\x12consecutive_scouts\x18\x05 \x01(\x05R\x11consecutiveScouts\x12\x14\n" +
+	"\x05round\x18\x06 \x01(\x05R\x05round\"\xa4\x01\n" +
+	"\fSeatSnapshot\x12'\n" +
+	"\x04hand\x18\x01 \x03(\v2\x13.scout.CardSnapshotR\x04hand\x12\x14\n" +
+	"\x05score\x18\x02 \x01(\x05R\x05score\x12(\n" +
+	"\x10can_reverse_hand\x18\x03 \x01(\bR\x0ecanReverseHand\x12+\n" +
+	"\x12can_scout_and_show\x18\x04 \x01(\bR\x0fcanScoutAndShow\"\xaa\x02\n" +
+	"\x0ePlayerSnapshot\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05index\x18\x02 \x01(\x05R\x05index\x12\x14\n" +
+	"\x05score\x18\x03 \x01(\x05R\x05score\x12'\n" +
+	"\x04hand\x18\x04 \x03(\v2\x13.scout.CardSnapshotR\x04hand\x12(\n" +
+	"\x10can_reverse_hand\x18\x05 \x01(\bR\x0ecanReverseHand\x12+\n" +
+	"\x12can_scout_and_show\x18\x06 \x01(\bR\x0fcanScoutAndShow\x12\x16\n" +
+	"\x06seated\x18\a \x01(\bR\x06seated\x12\x14\n" +
+	"\x05ready\x18\b \x01(\bR\x05ready\x12*\n" +
+	"\x11time_remaining_ms\x18\t \x01(\x03R\x0ftimeRemainingMs\"V\n" +
+	"\fCardSnapshot\x12\x16\n" +
+	"\x06value1\x18\x01 \x01(\x05R\x06value1\x12\x16\n" +
+	"\x06value2\x18\x02 \x01(\x05R\x06value2\x12\x16\n" +
+	"\x06public\x18\x03 \x01(\bR\x06public\"j\n" +
+	"\x04Move\x12!\n" +
+	"\fplayer_index\x18\x01 \x01(\x05R\vplayerIndex\x12%\n" +
+	"\x06action\x18\x02 \x01(\v2\r.scout.ActionR\x06action\x12\x18\n" +
+	"\atimeout\x18\x03 \x01(\bR\atimeout\",\n" +
+	"\x11ExportGameRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\"E\n" +
+	"\x12ExportGameResponse\x12/\n" +
+	"\bsnapshot\x18\x01 \x01(\v2\x13.scout.GameSnapshotR\bsnapshot\"D\n" +
+	"\x11ImportGameRequest\x12/\n" +
+	"\bsnapshot\x18\x01 \x01(\v2\x13.scout.GameSnapshotR\bsnapshot\"N\n" +
+	"\x12ImportGameResponse\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12\x1f\n" +
+	"\vseat_tokens\x18\x02 \x03(\tR\n" +
+	"seatTokens\"\xdb\x02\n" +
+	"\x1aCreateGameFromStateRequest\x12&\n" +
+	"\x05seats\x18\x01 \x03(\v2\x10.scout.SeatStateR\x05seats\x12*\n" +
+	"\n" +
+	"active_set\x18\x02 \x03(\v2\v.scout.CardR\tactiveSet\x125\n" +
+	"\x17active_set_player_index\x18\x03 \x01(\x05R\x14activeSetPlayerIndex\x12.\n" +
+	"\x13active_player_index\x18\x04 \x01(\x05R\x11activePlayerIndex\x12-\n" +
+	"\x12consecutive_scouts\x18\x05 \x01(\x05R\x11consecutiveScouts\x12\x14\n" +
+	"\x05round\x18\x06 \x01(\x05R\x05round\x12\x12\n" +
+	"\x04seed\x18\a \x01(\x03R\x04seed\x12)\n" +
+	"\x06config\x18\b \x01(\v2\x11.scout.GameConfigR\x06config\"\x99\x01\n" +
+	"\tSeatState\x12\x1f\n" +
+	"\x04hand\x18\x01 \x03(\v2\v.scout.CardR\x04hand\x12\x14\n" +
+	"\x05score\x18\x02 \x01(\x05R\x05score\x12(\n" +
+	"\x10can_reverse_hand\x18\x03 \x01(\bR\x0ecanReverseHand\x12+\n" +
+	"\x12can_scout_and_show\x18\x04 \x01(\bR\x0fcanScoutAndShow\"W\n" +
+	"\x1bCreateGameFromStateResponse\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12\x1f\n" +
+	"\vseat_tokens\x18\x02 \x03(\tR\n" +
+	"seatTokens\"2\n" +
+	"\x17ExportGameRecordRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\"2\n" +
+	"\x18ExportGameRecordResponse\x12\x16\n" +
+	"\x06record\x18\x01 \x01(\tR\x06record\"1\n" +
+	"\x17ImportGameRecordRequest\x12\x16\n" +
+	"\x06record\x18\x01 \x01(\tR\x06record\"T\n" +
+	"\x18ImportGameRecordResponse\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12\x1f\n" +
+	"\vseat_tokens\x18\x02 \x03(\tR\n" +
+	"seatTokens\"y\n" +
+	"\x14ExplainActionRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12!\n" +
+	"\fplayer_index\x18\x02 \x01(\x05R\vplayerIndex\x12%\n" +
+	"\x06action\x18\x03 \x01(\v2\r.scout.ActionR\x06action\"_\n" +
+	"\x15ExplainActionResponse\x12\x14\n" +
+	"\x05valid\x18\x01 \x01(\bR\x05valid\x120\n" +
+	"\bproblems\x18\x02 \x03(\v2\x14.scout.ActionProblemR\bproblems\"v\n" +
+	"\rActionProblem\x12(\n" +
+	"\x04code\x18\x01 \x01(\x0e2\x14.scout.ViolationCodeR\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12!\n" +
+	"\x05cards\x18\x03 \x03(\v2\v.scout.CardR\x05cards*@\n" +
+	"\tGamePhase\x12\x0e\n" +
+	"\n" +
+	"PhaseLobby\x10\x00\x12\x10\n" +
+	"\fPhasePlaying\x10\x01\x12\x11\n" +
+	"\rPhaseComplete\x10\x02*^\n" +
+	"\n" +
+	"Visibility\x12\x14\n" +
+	"\x10VisibilityPublic\x10\x00\x12\x19\n" +
+	"\x15VisibilityFullDelayed\x10\x01\x12\x1f\n" +
+	"\x1bVisibilityFullAfterComplete\x10\x02*\x84\x04\n" +
+	"\rViolationCode\x12\x14\n" +
+	"\x10ViolationUnknown\x10\x00\x12\x18\n" +
+	"\x14ViolationNotYourTurn\x10\x01\x12\x19\n" +
+	"\x15ViolationGameComplete\x10\x02\x12\x1b\n" +
+	"\x17ViolationGameNotStarted\x10\x03\x12\x18\n" +
+	"\x14ViolationGameStarted\x10\x04\x12\x1a\n" +
+	"\x16ViolationUnknownAction\x10\x05\x12\x1c\n" +
+	"\x18ViolationIndexOutOfRange\x10\x06\x12\x1b\n" +
+	"\x17ViolationNothingToScout\x10\a\x12\x1c\n" +
+	"\x18ViolationScoutNotFromEnd\x10\b\x12\x15\n" +
+	"\x11ViolationSetEmpty\x10\t\x12(\n" +
+	"$ViolationSetNotConsecutiveOrMatching\x10\n" +
+	"\x12\x17\n" +
+	"\x13ViolationSetTooWeak\x10\v\x12\x1d\n" +
+	"\x19ViolationScoutAndShowUsed\x10\f\x12\"\n" +
+	"\x1eViolationReverseHandNotAllowed\x10\r\x12\x15\n" +
+	"\x11ViolationGameFull\x10\x0e\x12\x16\n" +
+	"\x12ViolationSeatTaken\x10\x0f\x12\x16\n" +
+	"\x12ViolationSeatEmpty\x10\x10\x12\x18\n" +
+	"\x14ViolationNameTooLong\x10\x112\xfd\v\n" +
 	"\fScoutService\x12A\n" +
 	"\n" +
 	"CreateGame\x12\x18.scout.CreateGameRequest\x1a\x19.scout.CreateGameResponse\x12G\n" +
 	"\fPlayerAction\x12\x1a.scout.PlayerActionRequest\x1a\x1b.scout.PlayerActionResponse\x12G\n" +
 	"\fGetGameState\x12\x1a.scout.GetGameStateRequest\x1a\x1b.scout.GetGameStateResponse\x12M\n" +
 	"\x0eGetPlayerState\x12\x1c.scout.GetPlayerStateRequest\x1a\x1d.scout.GetPlayerStateResponse\x12P\n" +
-	"\x0fGetValidActions\x12\x1d.scout.GetValidActionsRequest\x1a\x1e.scout.GetValidActionsResponseB\x16Z\x14scout-go/proto;protob\x06proto3"
+	"\x0fGetValidActions\x12\x1d.scout.GetValidActionsRequest\x1a\x1e.scout.GetValidActionsResponse\x12D\n" +
+	"\vDeterminize\x12\x19.scout.DeterminizeRequest\x1a\x1a.scout.DeterminizeResponse\x12J\n" +
+	"\rRegisterAgent\x12\x1b.scout.RegisterAgentRequest\x1a\x1c.scout.RegisterAgentResponse\x128\n" +
+	"\tWatchGame\x12\x17.scout.WatchGameRequest\x1a\x10.scout.GameEvent0\x01\x12H\n" +
+	"\vPlaySession\x12\x19.scout.PlaySessionRequest\x1a\x1a.scout.PlaySessionResponse(\x010\x01\x12J\n" +
+	"\rListOpenGames\x12\x1b.scout.ListOpenGamesRequest\x1a\x1c.scout.ListOpenGamesResponse\x12;\n" +
+	"\bJoinGame\x12\x16.scout.JoinGameRequest\x1a\x17.scout.JoinGameResponse\x12>\n" +
+	"\tLeaveGame\x12\x17.scout.LeaveGameRequest\x1a\x18.scout.LeaveGameResponse\x12;\n" +
+	"\bSetReady\x12\x16.scout.SetReadyRequest\x1a\x17.scout.SetReadyResponse\x12>\n" +
+	"\tListGames\x12\x17.scout.ListGamesRequest\x1a\x18.scout.ListGamesResponse\x12A\n" +
+	"\n" +
+	"DeleteGame\x12\x18.scout.DeleteGameRequest\x1a\x19.scout.DeleteGameResponse\x12A\n" +
+	"\n" +
+	"ExportGame\x12\x18.scout.ExportGameRequest\x1a\x19.scout.ExportGameResponse\x12A\n" +
+	"\n" +
+	"ImportGame\x12\x18.scout.ImportGameRequest\x1a\x19.scout.ImportGameResponse\x12\\\n" +
+	"\x13CreateGameFromState\x12!.scout.CreateGameFromStateRequest\x1a\".scout.CreateGameFromStateResponse\x12S\n" +
+	"\x10ExportGameRecord\x12\x1e.scout.ExportGameRecordRequest\x1a\x1f.scout.ExportGameRecordResponse\x12S\n" +
+	"\x10ImportGameRecord\x12\x1e.scout.ImportGameRecordRequest\x1a\x1f.scout.ImportGameRecordResponse\x12J\n" +
+	"\rExplainAction\x12\x1b.scout.ExplainActionRequest\x1a\x1c.scout.ExplainActionResponse2W\n" +
+	"\fAgentService\x12G\n" +
+	"\fChooseAction\x12\x1a.scout.ChooseActionRequest\x1a\x1b.scout.ChooseActionResponseB\x16Z\x14scout-go/proto;protob\x06proto3"
 
 var (
 	file_proto_scout_proto_rawDescOnce sync.Once
@@ -1042,49 +4776,188 @@ func file_proto_scout_proto_rawDescGZIP() []byte {
 	return file_proto_scout_proto_rawDescData
 }
 
-var file_proto_scout_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_proto_scout_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_proto_scout_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_proto_scout_proto_msgTypes = make([]protoimpl.MessageInfo, 64)
 var file_proto_scout_proto_goTypes = []any{
-	(Action_ActionType)(0),          // 0: scout.Action.ActionType
-	(*Action)(nil),                  // 1: scout.Action
-	(*Game)(nil),                    // 2: scout.Game
-	(*Player)(nil),                  // 3: scout.Player
-	(*Card)(nil),                    // 4: scout.Card
-	(*PlayerState)(nil),             // 5: scout.PlayerState
-	(*CreateGameRequest)(nil),       // 6: scout.CreateGameRequest
-	(*CreateGameResponse)(nil),      // 7: scout.CreateGameResponse
-	(*PlayerActionRequest)(nil),     // 8: scout.PlayerActionRequest
-	(*PlayerActionResponse)(nil),    // 9: scout.PlayerActionResponse
-	(*GetGameStateRequest)(nil),     // 10: scout.GetGameStateRequest
-	(*GetGameStateResponse)(nil),    // 11: scout.GetGameStateResponse
-	(*GetPlayerStateRequest)(nil),   // 12: scout.GetPlayerStateRequest
-	(*GetPlayerStateResponse)(nil),  // 13: scout.GetPlayerStateResponse
-	(*GetValidActionsRequest)(nil),  // 14: scout.GetValidActionsRequest
-	(*GetValidActionsResponse)(nil), // 15: scout.GetValidActionsResponse
+	(GamePhase)(0),                      // 0: scout.GamePhase
+	(Visibility)(0),                     // 1: scout.Visibility
+	(ViolationCode)(0),                  // 2: scout.ViolationCode
+	(Action_ActionType)(0),              // 3: scout.Action.ActionType
+	(GameEvent_EventType)(0),            // 4: scout.GameEvent.EventType
+	(*Action)(nil),                      // 5: scout.Action
+	(*Game)(nil),                        // 6: scout.Game
+	(*GameConfig)(nil),                  // 7: scout.GameConfig
+	(*Player)(nil),                      // 8: scout.Player
+	(*Card)(nil),                        // 9: scout.Card
+	(*PlayerState)(nil),                 // 10: scout.PlayerState
+	(*PublicCard)(nil),                  // 11: scout.PublicCard
+	(*CreateGameRequest)(nil),           // 12: scout.CreateGameRequest
+	(*CreateGameResponse)(nil),          // 13: scout.CreateGameResponse
+	(*PlayerActionRequest)(nil),         // 14: scout.PlayerActionRequest
+	(*PlayerActionResponse)(nil),        // 15: scout.PlayerActionResponse
+	(*RuleViolation)(nil),               // 16: scout.RuleViolation
+	(*GetGameStateRequest)(nil),         // 17: scout.GetGameStateRequest
+	(*GetGameStateResponse)(nil),        // 18: scout.GetGameStateResponse
+	(*GetPlayerStateRequest)(nil),       // 19: scout.GetPlayerStateRequest
+	(*GetPlayerStateResponse)(nil),      // 20: scout.GetPlayerStateResponse
+	(*GetValidActionsRequest)(nil),      // 21: scout.GetValidActionsRequest
+	(*GetValidActionsResponse)(nil),     // 22: scout.GetValidActionsResponse
+	(*DeterminizeRequest)(nil),          // 23: scout.DeterminizeRequest
+	(*Determinization)(nil),             // 24: scout.Determinization
+	(*DeterminizeResponse)(nil),         // 25: scout.DeterminizeResponse
+	(*RegisterAgentRequest)(nil),        // 26: scout.RegisterAgentRequest
+	(*RegisterAgentResponse)(nil),       // 27: scout.RegisterAgentResponse
+	(*Observation)(nil),                 // 28: scout.Observation
+	(*ChooseActionRequest)(nil),         // 29: scout.ChooseActionRequest
+	(*ChooseActionResponse)(nil),        // 30: scout.ChooseActionResponse
+	(*WatchGameRequest)(nil),            // 31: scout.WatchGameRequest
+	(*GameEvent)(nil),                   // 32: scout.GameEvent
+	(*PlaySessionRequest)(nil),          // 33: scout.PlaySessionRequest
+	(*JoinSeat)(nil),                    // 34: scout.JoinSeat
+	(*PlaySessionResponse)(nil),         // 35: scout.PlaySessionResponse
+	(*Prompt)(nil),                      // 36: scout.Prompt
+	(*ListOpenGamesRequest)(nil),        // 37: scout.ListOpenGamesRequest
+	(*ListOpenGamesResponse)(nil),       // 38: scout.ListOpenGamesResponse
+	(*JoinGameRequest)(nil),             // 39: scout.JoinGameRequest
+	(*JoinGameResponse)(nil),            // 40: scout.JoinGameResponse
+	(*LeaveGameRequest)(nil),            // 41: scout.LeaveGameRequest
+	(*LeaveGameResponse)(nil),           // 42: scout.LeaveGameResponse
+	(*SetReadyRequest)(nil),             // 43: scout.SetReadyRequest
+	(*SetReadyResponse)(nil),            // 44: scout.SetReadyResponse
+	(*ListGamesRequest)(nil),            // 45: scout.ListGamesRequest
+	(*ListGamesResponse)(nil),           // 46: scout.ListGamesResponse
+	(*DeleteGameRequest)(nil),           // 47: scout.DeleteGameRequest
+	(*DeleteGameResponse)(nil),          // 48: scout.DeleteGameResponse
+	(*GameSnapshot)(nil),                // 49: scout.GameSnapshot
+	(*PositionSnapshot)(nil),            // 50: scout.PositionSnapshot
+	(*SeatSnapshot)(nil),                // 51: scout.SeatSnapshot
+	(*PlayerSnapshot)(nil),              // 52: scout.PlayerSnapshot
+	(*CardSnapshot)(nil),                // 53: scout.CardSnapshot
+	(*Move)(nil),                        // 54: scout.Move
+	(*ExportGameRequest)(nil),           // 55: scout.ExportGameRequest
+	(*ExportGameResponse)(nil),          // 56: scout.ExportGameResponse
+	(*ImportGameRequest)(nil),           // 57: scout.ImportGameRequest
+	(*ImportGameResponse)(nil),          // 58: scout.ImportGameResponse
+	(*CreateGameFromStateRequest)(nil),  // 59: scout.CreateGameFromStateRequest
+	(*SeatState)(nil),                   // 60: scout.SeatState
+	(*CreateGameFromStateResponse)(nil), // 61: scout.CreateGameFromStateResponse
+	(*ExportGameRecordRequest)(nil),     // 62: scout.ExportGameRecordRequest
+	(*ExportGameRecordResponse)(nil),    // 63: scout.ExportGameRecordResponse
+	(*ImportGameRecordRequest)(nil),     // 64: scout.ImportGameRecordRequest
+	(*ImportGameRecordResponse)(nil),    // 65: scout.ImportGameRecordResponse
+	(*ExplainActionRequest)(nil),        // 66: scout.ExplainActionRequest
+	(*ExplainActionResponse)(nil),       // 67: scout.ExplainActionResponse
+	(*ActionProblem)(nil),               // 68: scout.ActionProblem
 }
 var file_proto_scout_proto_depIdxs = []int32{
-	0,  // 0: scout.Action.action_type:type_name -> scout.Action.ActionType
-	4,  // 1: scout.Game.active_set:type_name -> scout.Card
-	5,  // 2: scout.Game.player_states:type_name -> scout.PlayerState
-	4,  // 3: scout.Player.hand:type_name -> scout.Card
-	1,  // 4: scout.PlayerActionRequest.action:type_name -> scout.Action
-	2,  // 5: scout.GetGameStateResponse.game:type_name -> scout.Game
-	3,  // 6: scout.GetPlayerStateResponse.player:type_name -> scout.Player
-	6,  // 7: scout.ScoutService.CreateGame:input_type -> scout.CreateGameRequest
-	8,  // 8: scout.ScoutService.PlayerAction:input_type -> scout.PlayerActionRequest
-	10, // 9: scout.ScoutService.GetGameState:input_type -> scout.GetGameStateRequest
-	12, // 10: scout.ScoutService.GetPlayerState:input_type -> scout.GetPlayerStateRequest
-	14, // 11: scout.ScoutService.GetValidActions:input_type -> scout.GetValidActionsRequest
-	7,  // 12: scout.ScoutService.CreateGame:output_type -> scout.CreateGameResponse
-	9,  // 13: scout.ScoutService.PlayerAction:output_type -> scout.PlayerActionResponse
-	11, // 14: scout.ScoutService.GetGameState:output_type -> scout.GetGameStateResponse
-	13, // 15: scout.ScoutService.GetPlayerState:output_type -> scout.GetPlayerStateResponse
-	15, // 16: scout.ScoutService.GetValidActions:output_type -> scout.GetValidActionsResponse
-	12, // [12:17] is the sub-list for method output_type
-	7,  // [7:12] is the sub-list for method input_type
-	7,  // [7:7] is the sub-list for extension type_name
-	7,  // [7:7] is the sub-list for extension extendee
-	0,  // [0:7] is the sub-list for field type_name
+	3,  // 0: scout.Action.action_type:type_name -> scout.Action.ActionType
+	9,  // 1: scout.Game.active_set:type_name -> scout.Card
+	10, // 2: scout.Game.player_states:type_name -> scout.PlayerState
+	0,  // 3: scout.Game.phase:type_name -> scout.GamePhase
+	7,  // 4: scout.Game.config:type_name -> scout.GameConfig
+	1,  // 5: scout.GameConfig.spectator_visibility:type_name -> scout.Visibility
+	9,  // 6: scout.Player.hand:type_name -> scout.Card
+	11, // 7: scout.PlayerState.public_cards:type_name -> scout.PublicCard
+	9,  // 8: scout.PublicCard.card:type_name -> scout.Card
+	7,  // 9: scout.CreateGameRequest.config:type_name -> scout.GameConfig
+	5,  // 10: scout.PlayerActionRequest.action:type_name -> scout.Action
+	2,  // 11: scout.PlayerActionResponse.violation_code:type_name -> scout.ViolationCode
+	2,  // 12: scout.RuleViolation.code:type_name -> scout.ViolationCode
+	6,  // 13: scout.GetGameStateResponse.game:type_name -> scout.Game
+	8,  // 14: scout.GetPlayerStateResponse.player:type_name -> scout.Player
+	6,  // 15: scout.Determinization.game:type_name -> scout.Game
+	8,  // 16: scout.Determinization.players:type_name -> scout.Player
+	24, // 17: scout.DeterminizeResponse.samples:type_name -> scout.Determinization
+	6,  // 18: scout.Observation.game:type_name -> scout.Game
+	8,  // 19: scout.Observation.player:type_name -> scout.Player
+	28, // 20: scout.ChooseActionRequest.observation:type_name -> scout.Observation
+	1,  // 21: scout.WatchGameRequest.visibility:type_name -> scout.Visibility
+	4,  // 22: scout.GameEvent.event_type:type_name -> scout.GameEvent.EventType
+	5,  // 23: scout.GameEvent.action:type_name -> scout.Action
+	9,  // 24: scout.GameEvent.scouted_card:type_name -> scout.Card
+	9,  // 25: scout.GameEvent.shown_cards:type_name -> scout.Card
+	6,  // 26: scout.GameEvent.game:type_name -> scout.Game
+	8,  // 27: scout.GameEvent.player:type_name -> scout.Player
+	8,  // 28: scout.GameEvent.players:type_name -> scout.Player
+	34, // 29: scout.PlaySessionRequest.join:type_name -> scout.JoinSeat
+	5,  // 30: scout.PlaySessionRequest.action:type_name -> scout.Action
+	8,  // 31: scout.PlaySessionResponse.joined:type_name -> scout.Player
+	36, // 32: scout.PlaySessionResponse.prompt:type_name -> scout.Prompt
+	15, // 33: scout.PlaySessionResponse.result:type_name -> scout.PlayerActionResponse
+	32, // 34: scout.PlaySessionResponse.event:type_name -> scout.GameEvent
+	28, // 35: scout.Prompt.observation:type_name -> scout.Observation
+	6,  // 36: scout.ListOpenGamesResponse.games:type_name -> scout.Game
+	0,  // 37: scout.ListGamesRequest.phases:type_name -> scout.GamePhase
+	6,  // 38: scout.ListGamesResponse.games:type_name -> scout.Game
+	52, // 39: scout.GameSnapshot.players:type_name -> scout.PlayerSnapshot
+	53, // 40: scout.GameSnapshot.active_set:type_name -> scout.CardSnapshot
+	7,  // 41: scout.GameSnapshot.config:type_name -> scout.GameConfig
+	54, // 42: scout.GameSnapshot.history:type_name -> scout.Move
+	50, // 43: scout.GameSnapshot.start:type_name -> scout.PositionSnapshot
+	51, // 44: scout.PositionSnapshot.seats:type_name -> scout.SeatSnapshot
+	53, // 45: scout.PositionSnapshot.active_set:type_name -> scout.CardSnapshot
+	53, // 46: scout.SeatSnapshot.hand:type_name -> scout.CardSnapshot
+	53, // 47: scout.PlayerSnapshot.hand:type_name -> scout.CardSnapshot
+	5,  // 48: scout.Move.action:type_name -> scout.Action
+	49, // 49: scout.ExportGameResponse.snapshot:type_name -> scout.GameSnapshot
+	49, // 50: scout.ImportGameRequest.snapshot:type_name -> scout.GameSnapshot
+	60, // 51: scout.CreateGameFromStateRequest.seats:type_name -> scout.SeatState
+	9,  // 52: scout.CreateGameFromStateRequest.active_set:type_name -> scout.Card
+	7,  // 53: scout.CreateGameFromStateRequest.config:type_name -> scout.GameConfig
+	9,  // 54: scout.SeatState.hand:type_name -> scout.Card
+	5,  // 55: scout.ExplainActionRequest.action:type_name -> scout.Action
+	68, // 56: scout.ExplainActionResponse.problems:type_name -> scout.ActionProblem
+	2,  // 57: scout.ActionProblem.code:type_name -> scout.ViolationCode
+	9,  // 58: scout.ActionProblem.cards:type_name -> scout.Card
+	12, // 59: scout.ScoutService.CreateGame:input_type -> scout.CreateGameRequest
+	14, // 60: scout.ScoutService.PlayerAction:input_type -> scout.PlayerActionRequest
+	17, // 61: scout.ScoutService.GetGameState:input_type -> scout.GetGameStateRequest
+	19, // 62: scout.ScoutService.GetPlayerState:input_type -> scout.GetPlayerStateRequest
+	21, // 63: scout.ScoutService.GetValidActions:input_type -> scout.GetValidActionsRequest
+	23, // 64: scout.ScoutService.Determinize:input_type -> scout.DeterminizeRequest
+	26, // 65: scout.ScoutService.RegisterAgent:input_type -> scout.RegisterAgentRequest
+	31, // 66: scout.ScoutService.WatchGame:input_type -> scout.WatchGameRequest
+	33, // 67: scout.ScoutService.PlaySession:input_type -> scout.PlaySessionRequest
+	37, // 68: scout.ScoutService.ListOpenGames:input_type -> scout.ListOpenGamesRequest
+	39, // 69: scout.ScoutService.JoinGame:input_type -> scout.JoinGameRequest
+	41, // 70: scout.ScoutService.LeaveGame:input_type -> scout.LeaveGameRequest
+	43, // 71: scout.ScoutService.SetReady:input_type -> scout.SetReadyRequest
+	45, // 72: scout.ScoutService.ListGames:input_type -> scout.ListGamesRequest
+	47, // 73: scout.ScoutService.DeleteGame:input_type -> scout.DeleteGameRequest
+	55, // 74: scout.ScoutService.ExportGame:input_type -> scout.ExportGameRequest
+	57, // 75: scout.ScoutService.ImportGame:input_type -> scout.ImportGameRequest
+	59, // 76: scout.ScoutService.CreateGameFromState:input_type -> scout.CreateGameFromStateRequest
+	62, // 77: scout.ScoutService.ExportGameRecord:input_type -> scout.ExportGameRecordRequest
+	64, // 78: scout.ScoutService.ImportGameRecord:input_type -> scout.ImportGameRecordRequest
+	66, // 79: scout.ScoutService.ExplainAction:input_type -> scout.ExplainActionRequest
+	29, // 80: scout.AgentService.ChooseAction:input_type -> scout.ChooseActionRequest
+	13, // 81: scout.ScoutService.CreateGame:output_type -> scout.CreateGameResponse
+	15, // 82: scout.ScoutService.PlayerAction:output_type -> scout.PlayerActionResponse
+	18, // 83: scout.ScoutService.GetGameState:output_type -> scout.GetGameStateResponse
+	20, // 84: scout.ScoutService.GetPlayerState:output_type -> scout.GetPlayerStateResponse
+	22, // 85: scout.ScoutService.GetValidActions:output_type -> scout.GetValidActionsResponse
+	25, // 86: scout.ScoutService.Determinize:output_type -> scout.DeterminizeResponse
+	27, // 87: scout.ScoutService.RegisterAgent:output_type -> scout.RegisterAgentResponse
+	32, // 88: scout.ScoutService.WatchGame:output_type -> scout.GameEvent
+	35, // 89: scout.ScoutService.PlaySession:output_type -> scout.PlaySessionResponse
+	38, // 90: scout.ScoutService.ListOpenGames:output_type -> scout.ListOpenGamesResponse
+	40, // 91: scout.ScoutService.JoinGame:output_type -> scout.JoinGameResponse
+	42, // 92: scout.ScoutService.LeaveGame:output_type -> scout.LeaveGameResponse
+	44, // 93: scout.ScoutService.SetReady:output_type -> scout.SetReadyResponse
+	46, // 94: scout.ScoutService.ListGames:output_type -> scout.ListGamesResponse
+	48, // 95: scout.ScoutService.DeleteGame:output_type -> scout.DeleteGameResponse
+	56, // 96: scout.ScoutService.ExportGame:output_type -> scout.ExportGameResponse
+	58, // 97: scout.ScoutService.ImportGame:output_type -> scout.ImportGameResponse
+	61, // 98: scout.ScoutService.CreateGameFromState:output_type -> scout.CreateGameFromStateResponse
+	63, // 99: scout.ScoutService.ExportGameRecord:output_type -> scout.ExportGameRecordResponse
+	65, // 100: scout.ScoutService.ImportGameRecord:output_type -> scout.ImportGameRecordResponse
+	67, // 101: scout.ScoutService.ExplainAction:output_type -> scout.ExplainActionResponse
+	30, // 102: scout.AgentService.ChooseAction:output_type -> scout.ChooseActionResponse
+	81, // [81:103] is the sub-list for method output_type
+	59, // [59:81] is the sub-list for method input_type
+	59, // [59:59] is the sub-list for extension type_name
+	59, // [59:59] is the sub-list for extension extendee
+	0,  // [0:59] is the sub-list for field type_name
 }
 
 func init() { file_proto_scout_proto_init() }
@@ -1092,15 +4965,26 @@ func file_proto_scout_proto_init() {
 	if File_proto_scout_proto != nil {
 		return
 	}
+	file_proto_scout_proto_msgTypes[2].OneofWrappers = []any{}
+	file_proto_scout_proto_msgTypes[28].OneofWrappers = []any{
+		(*PlaySessionRequest_Join)(nil),
+		(*PlaySessionRequest_Action)(nil),
+	}
+	file_proto_scout_proto_msgTypes[30].OneofWrappers = []any{
+		(*PlaySessionResponse_Joined)(nil),
+		(*PlaySessionResponse_Prompt)(nil),
+		(*PlaySessionResponse_Result)(nil),
+		(*PlaySessionResponse_Event)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_scout_proto_rawDesc), len(file_proto_scout_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   15,
+			NumEnums:      5,
+			NumMessages:   64,
 			NumExtensions: 0,
-			NumServices:   1,
+			NumServices:   2,
 		},
 		GoTypes:           file_proto_scout_proto_goTypes,
 		DependencyIndexes: file_proto_scout_proto_depIdxs,
@@ -19,11 +19,27 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ScoutService_CreateGame_FullMethodName      = "/scout.ScoutService/CreateGame"
-	ScoutService_PlayerAction_FullMethodName    = "/scout.ScoutService/PlayerAction"
-	ScoutService_GetGameState_FullMethodName    = "/scout.ScoutService/GetGameState"
-	ScoutService_GetPlayerState_FullMethodName  = "/scout.ScoutService/GetPlayerState"
-	ScoutService_GetValidActions_FullMethodName = "/scout.ScoutService/GetValidActions"
+	ScoutService_CreateGame_FullMethodName          = "/scout.ScoutService/CreateGame"
+	ScoutService_PlayerAction_FullMethodName        = "/scout.ScoutService/PlayerAction"
+	ScoutService_GetGameState_FullMethodName        = "/scout.ScoutService/GetGameState"
+	ScoutService_GetPlayerState_FullMethodName      = "/scout.ScoutService/GetPlayerState"
+	ScoutService_GetValidActions_FullMethodName     = "/scout.ScoutService/GetValidActions"
+	ScoutService_Determinize_FullMethodName         = "/scout.ScoutService/Determinize"
+	ScoutService_RegisterAgent_FullMethodName       = "/scout.ScoutService/RegisterAgent"
+	ScoutService_WatchGame_FullMethodName           = "/scout.ScoutService/WatchGame"
+	ScoutService_PlaySession_FullMethodName         = "/scout.ScoutService/PlaySession"
+	ScoutService_ListOpenGames_FullMethodName       = "/scout.ScoutService/ListOpenGames"
+	ScoutService_JoinGame_FullMethodName            = "/scout.ScoutService/JoinGame"
+	ScoutService_LeaveGame_FullMethodName           = "/scout.ScoutService/LeaveGame"
+	ScoutService_SetReady_FullMethodName            = "/scout.ScoutService/SetReady"
+	ScoutService_ListGames_FullMethodName           = "/scout.ScoutService/ListGames"
+	ScoutService_DeleteGame_FullMethodName          = "/scout.ScoutService/DeleteGame"
+	ScoutService_ExportGame_FullMethodName          = "/scout.ScoutService/ExportGame"
+	ScoutService_ImportGame_FullMethodName          = "/scout.ScoutService/ImportGame"
+	ScoutService_CreateGameFromState_FullMethodName = "/scout.ScoutService/CreateGameFromState"
+	ScoutService_ExportGameRecord_FullMethodName    = "/scout.ScoutService/ExportGameRecord"
+	ScoutService_ImportGameRecord_FullMethodName    = "/scout.ScoutService/ImportGameRecord"
+	ScoutService_ExplainAction_FullMethodName       = "/scout.ScoutService/ExplainAction"
 )
 
 // ScoutServiceClient is the client API for ScoutService service.
@@ -35,6 +51,22 @@ type ScoutServiceClient interface {
 	GetGameState(ctx context.Context, in *GetGameStateRequest, opts ...grpc.CallOption) (*GetGameStateResponse, error)
 	GetPlayerState(ctx context.Context, in *GetPlayerStateRequest, opts ...grpc.CallOption) (*GetPlayerStateResponse, error)
 	GetValidActions(ctx context.Context, in *GetValidActionsRequest, opts ...grpc.CallOption) (*GetValidActionsResponse, error)
+	Determinize(ctx context.Context, in *DeterminizeRequest, opts ...grpc.CallOption) (*DeterminizeResponse, error)
+	RegisterAgent(ctx context.Context, in *RegisterAgentRequest, opts ...grpc.CallOption) (*RegisterAgentResponse, error)
+	WatchGame(ctx context.Context, in *WatchGameRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GameEvent], error)
+	PlaySession(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[PlaySessionRequest, PlaySessionResponse], error)
+	ListOpenGames(ctx context.Context, in *ListOpenGamesRequest, opts ...grpc.CallOption) (*ListOpenGamesResponse, error)
+	JoinGame(ctx context.Context, in *JoinGameRequest, opts ...grpc.CallOption) (*JoinGameResponse, error)
+	LeaveGame(ctx context.Context, in *LeaveGameRequest, opts ...grpc.CallOption) (*LeaveGameResponse, error)
+	SetReady(ctx context.Context, in *SetReadyRequest, opts ...grpc.CallOption) (*SetReadyResponse, error)
+	ListGames(ctx context.Context, in *ListGamesRequest, opts ...grpc.CallOption) (*ListGamesResponse, error)
+	DeleteGame(ctx context.Context, in *DeleteGameRequest, opts ...grpc.CallOption) (*DeleteGameResponse, error)
+	ExportGame(ctx context.Context, in *ExportGameRequest, opts ...grpc.CallOption) (*ExportGameResponse, error)
+	ImportGame(ctx context.Context, in *ImportGameRequest, opts ...grpc.CallOption) (*ImportGameResponse, error)
+	CreateGameFromState(ctx context.Context, in *CreateGameFromStateRequest, opts ...grpc.CallOption) (*CreateGameFromStateResponse, error)
+	ExportGameRecord(ctx context.Context, in *ExportGameRecordRequest, opts ...grpc.CallOption) (*ExportGameRecordResponse, error)
+	ImportGameRecord(ctx context.Context, in *ImportGameRecordRequest, opts ...grpc.CallOption) (*ImportGameRecordResponse, error)
+	ExplainAction(ctx context.Context, in *ExplainActionRequest, opts ...grpc.CallOption) (*ExplainActionResponse, error)
 }
 
 type scoutServiceClient struct {
@@ -95,6 +127,178 @@ func (c *scoutServiceClient) GetValidActions(ctx context.Context, in *GetValidAc
 	return out, nil
 }
 
+func (c *scoutServiceClient) Determinize(ctx context.Context, in *DeterminizeRequest, opts ...grpc.CallOption) (*DeterminizeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeterminizeResponse)
+	err := c.cc.Invoke(ctx, ScoutService_Determinize_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scoutServiceClient) RegisterAgent(ctx context.Context, in *RegisterAgentRequest, opts ...grpc.CallOption) (*RegisterAgentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RegisterAgentResponse)
+	err := c.cc.Invoke(ctx, ScoutService_RegisterAgent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scoutServiceClient) WatchGame(ctx context.Context, in *WatchGameRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GameEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ScoutService_ServiceDesc.Streams[0], ScoutService_WatchGame_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchGameRequest, GameEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ScoutService_WatchGameClient = grpc.ServerStreamingClient[GameEvent]
+
+func (c *scoutServiceClient) PlaySession(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[PlaySessionRequest, PlaySessionResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ScoutService_ServiceDesc.Streams[1], ScoutService_PlaySession_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[PlaySessionRequest, PlaySessionResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ScoutService_PlaySessionClient = grpc.BidiStreamingClient[PlaySessionRequest, PlaySessionResponse]
+
+func (c *scoutServiceClient) ListOpenGames(ctx context.Context, in *ListOpenGamesRequest, opts ...grpc.CallOption) (*ListOpenGamesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListOpenGamesResponse)
+	err := c.cc.Invoke(ctx, ScoutService_ListOpenGames_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scoutServiceClient) JoinGame(ctx context.Context, in *JoinGameRequest, opts ...grpc.CallOption) (*JoinGameResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JoinGameResponse)
+	err := c.cc.Invoke(ctx, ScoutService_JoinGame_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scoutServiceClient) LeaveGame(ctx context.Context, in *LeaveGameRequest, opts ...grpc.CallOption) (*LeaveGameResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LeaveGameResponse)
+	err := c.cc.Invoke(ctx, ScoutService_LeaveGame_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scoutServiceClient) SetReady(ctx context.Context, in *SetReadyRequest, opts ...grpc.CallOption) (*SetReadyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetReadyResponse)
+	err := c.cc.Invoke(ctx, ScoutService_SetReady_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scoutServiceClient) ListGames(ctx context.Context, in *ListGamesRequest, opts ...grpc.CallOption) (*ListGamesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListGamesResponse)
+	err := c.cc.Invoke(ctx, ScoutService_ListGames_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scoutServiceClient) DeleteGame(ctx context.Context, in *DeleteGameRequest, opts ...grpc.CallOption) (*DeleteGameResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteGameResponse)
+	err := c.cc.Invoke(ctx, ScoutService_DeleteGame_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scoutServiceClient) ExportGame(ctx context.Context, in *ExportGameRequest, opts ...grpc.CallOption) (*ExportGameResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportGameResponse)
+	err := c.cc.Invoke(ctx, ScoutService_ExportGame_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scoutServiceClient) ImportGame(ctx context.Context, in *ImportGameRequest, opts ...grpc.CallOption) (*ImportGameResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportGameResponse)
+	err := c.cc.Invoke(ctx, ScoutService_ImportGame_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scoutServiceClient) CreateGameFromState(ctx context.Context, in *CreateGameFromStateRequest, opts ...grpc.CallOption) (*CreateGameFromStateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateGameFromStateResponse)
+	err := c.cc.Invoke(ctx, ScoutService_CreateGameFromState_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scoutServiceClient) ExportGameRecord(ctx context.Context, in *ExportGameRecordRequest, opts ...grpc.CallOption) (*ExportGameRecordResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportGameRecordResponse)
+	err := c.cc.Invoke(ctx, ScoutService_ExportGameRecord_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scoutServiceClient) ImportGameRecord(ctx context.Context, in *ImportGameRecordRequest, opts ...grpc.CallOption) (*ImportGameRecordResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportGameRecordResponse)
+	err := c.cc.Invoke(ctx, ScoutService_ImportGameRecord_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scoutServiceClient) ExplainAction(ctx context.Context, in *ExplainActionRequest, opts ...grpc.CallOption) (*ExplainActionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExplainActionResponse)
+	err := c.cc.Invoke(ctx, ScoutService_ExplainAction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ScoutServiceServer is the server API for ScoutService service.
 // All implementations must embed UnimplementedScoutServiceServer
 // for forward compatibility.
@@ -104,6 +308,22 @@ type ScoutServiceServer interface {
 	GetGameState(context.Context, *GetGameStateRequest) (*GetGameStateResponse, error)
 	GetPlayerState(context.Context, *GetPlayerStateRequest) (*GetPlayerStateResponse, error)
 	GetValidActions(context.Context, *GetValidActionsRequest) (*GetValidActionsResponse, error)
+	Determinize(context.Context, *DeterminizeRequest) (*DeterminizeResponse, error)
+	RegisterAgent(context.Context, *RegisterAgentRequest) (*RegisterAgentResponse, error)
+	WatchGame(*WatchGameRequest, grpc.ServerStreamingServer[GameEvent]) error
+	PlaySession(grpc.BidiStreamingServer[PlaySessionRequest, PlaySessionResponse]) error
+	ListOpenGames(context.Context, *ListOpenGamesRequest) (*ListOpenGamesResponse, error)
+	JoinGame(context.Context, *JoinGameRequest) (*JoinGameResponse, error)
+	LeaveGame(context.Context, *LeaveGameRequest) (*LeaveGameResponse, error)
+	SetReady(context.Context, *SetReadyRequest) (*SetReadyResponse, error)
+	ListGames(context.Context, *ListGamesRequest) (*ListGamesResponse, error)
+	DeleteGame(context.Context, *DeleteGameRequest) (*DeleteGameResponse, error)
+	ExportGame(context.Context, *ExportGameRequest) (*ExportGameResponse, error)
+	ImportGame(context.Context, *ImportGameRequest) (*ImportGameResponse, error)
+	CreateGameFromState(context.Context, *CreateGameFromStateRequest) (*CreateGameFromStateResponse, error)
+	ExportGameRecord(context.Context, *ExportGameRecordRequest) (*ExportGameRecordResponse, error)
+	ImportGameRecord(context.Context, *ImportGameRecordRequest) (*ImportGameRecordResponse, error)
+	ExplainAction(context.Context, *ExplainActionRequest) (*ExplainActionResponse, error)
 	mustEmbedUnimplementedScoutServiceServer()
 }
 
@@ -129,6 +349,54 @@ func (UnimplementedScoutServiceServer) GetPlayerState(context.Context, *GetPlaye
 func (UnimplementedScoutServiceServer) GetValidActions(context.Context, *GetValidActionsRequest) (*GetValidActionsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetValidActions not implemented")
 }
+func (UnimplementedScoutServiceServer) Determinize(context.Context, *DeterminizeRequest) (*DeterminizeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Determinize not implemented")
+}
+func (UnimplementedScoutServiceServer) RegisterAgent(context.Context, *RegisterAgentRequest) (*RegisterAgentResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RegisterAgent not implemented")
+}
+func (UnimplementedScoutServiceServer) WatchGame(*WatchGameRequest, grpc.ServerStreamingServer[GameEvent]) error {
+	return status.Error(codes.Unimplemented, "method WatchGame not implemented")
+}
+func (UnimplementedScoutServiceServer) PlaySession(grpc.BidiStreamingServer[PlaySessionRequest, PlaySessionResponse]) error {
+	return status.Error(codes.Unimplemented, "method PlaySession not implemented")
+}
+func (UnimplementedScoutServiceServer) ListOpenGames(context.Context, *ListOpenGamesRequest) (*ListOpenGamesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListOpenGames not implemented")
+}
+func (UnimplementedScoutServiceServer) JoinGame(context.Context, *JoinGameRequest) (*JoinGameResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method JoinGame not implemented")
+}
+func (UnimplementedScoutServiceServer) LeaveGame(context.Context, *LeaveGameRequest) (*LeaveGameResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method LeaveGame not implemented")
+}
+func (UnimplementedScoutServiceServer) SetReady(context.Context, *SetReadyRequest) (*SetReadyResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetReady not implemented")
+}
+func (UnimplementedScoutServiceServer) ListGames(context.Context, *ListGamesRequest) (*ListGamesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListGames not implemented")
+}
+func (UnimplementedScoutServiceServer) DeleteGame(context.Context, *DeleteGameRequest) (*DeleteGameResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteGame not implemented")
+}
+func (UnimplementedScoutServiceServer) ExportGame(context.Context, *ExportGameRequest) (*ExportGameResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ExportGame not implemented")
+}
+func (UnimplementedScoutServiceServer) ImportGame(context.Context, *ImportGameRequest) (*ImportGameResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ImportGame not implemented")
+}
+func (UnimplementedScoutServiceServer) CreateGameFromState(context.Context, *CreateGameFromStateRequest) (*CreateGameFromStateResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateGameFromState not implemented")
+}
+func (UnimplementedScoutServiceServer) ExportGameRecord(context.Context, *ExportGameRecordRequest) (*ExportGameRecordResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ExportGameRecord not implemented")
+}
+func (UnimplementedScoutServiceServer) ImportGameRecord(context.Context, *ImportGameRecordRequest) (*ImportGameRecordResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ImportGameRecord not implemented")
+}
+func (UnimplementedScoutServiceServer) ExplainAction(context.Context, *ExplainActionRequest) (*ExplainActionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ExplainAction not implemented")
+}
 func (UnimplementedScoutServiceServer) mustEmbedUnimplementedScoutServiceServer() {}
 func (UnimplementedScoutServiceServer) testEmbeddedByValue()                      {}
 
@@ -240,6 +508,276 @@ func _ScoutService_GetValidActions_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ScoutService_Determinize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeterminizeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScoutServiceServer).Determinize(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScoutService_Determinize_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScoutServiceServer).Determinize(ctx, req.(*DeterminizeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScoutService_RegisterAgent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterAgentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScoutServiceServer).RegisterAgent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScoutService_RegisterAgent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScoutServiceServer).RegisterAgent(ctx, req.(*RegisterAgentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScoutService_WatchGame_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchGameRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ScoutServiceServer).WatchGame(m, &grpc.GenericServerStream[WatchGameRequest, GameEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ScoutService_WatchGameServer = grpc.ServerStreamingServer[GameEvent]
+
+func _ScoutService_PlaySession_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ScoutServiceServer).PlaySession(&grpc.GenericServerStream[PlaySessionRequest, PlaySessionResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ScoutService_PlaySessionServer = grpc.BidiStreamingServer[PlaySessionRequest, PlaySessionResponse]
+
+func _ScoutService_ListOpenGames_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOpenGamesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScoutServiceServer).ListOpenGames(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScoutService_ListOpenGames_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScoutServiceServer).ListOpenGames(ctx, req.(*ListOpenGamesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScoutService_JoinGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JoinGameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScoutServiceServer).JoinGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScoutService_JoinGame_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScoutServiceServer).JoinGame(ctx, req.(*JoinGameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScoutService_LeaveGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaveGameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScoutServiceServer).LeaveGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScoutService_LeaveGame_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScoutServiceServer).LeaveGame(ctx, req.(*LeaveGameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScoutService_SetReady_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetReadyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScoutServiceServer).SetReady(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScoutService_SetReady_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScoutServiceServer).SetReady(ctx, req.(*SetReadyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScoutService_ListGames_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGamesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScoutServiceServer).ListGames(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScoutService_ListGames_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScoutServiceServer).ListGames(ctx, req.(*ListGamesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScoutService_DeleteGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteGameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScoutServiceServer).DeleteGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScoutService_DeleteGame_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScoutServiceServer).DeleteGame(ctx, req.(*DeleteGameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScoutService_ExportGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportGameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScoutServiceServer).ExportGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScoutService_ExportGame_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScoutServiceServer).ExportGame(ctx, req.(*ExportGameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScoutService_ImportGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportGameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScoutServiceServer).ImportGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScoutService_ImportGame_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScoutServiceServer).ImportGame(ctx, req.(*ImportGameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScoutService_CreateGameFromState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGameFromStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScoutServiceServer).CreateGameFromState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScoutService_CreateGameFromState_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScoutServiceServer).CreateGameFromState(ctx, req.(*CreateGameFromStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScoutService_ExportGameRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportGameRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScoutServiceServer).ExportGameRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScoutService_ExportGameRecord_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScoutServiceServer).ExportGameRecord(ctx, req.(*ExportGameRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScoutService_ImportGameRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportGameRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScoutServiceServer).ImportGameRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScoutService_ImportGameRecord_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScoutServiceServer).ImportGameRecord(ctx, req.(*ImportGameRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScoutService_ExplainAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExplainActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScoutServiceServer).ExplainAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScoutService_ExplainAction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScoutServiceServer).ExplainAction(ctx, req.(*ExplainActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ScoutService_ServiceDesc is the grpc.ServiceDesc for ScoutService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -267,6 +805,182 @@ var ScoutService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetValidActions",
 			Handler:    _ScoutService_GetValidActions_Handler,
 		},
+		{
+			MethodName: "Determinize",
+			Handler:    _ScoutService_Determinize_Handler,
+		},
+		{
+			MethodName: "RegisterAgent",
+			Handler:    _ScoutService_RegisterAgent_Handler,
+		},
+		{
+			MethodName: "ListOpenGames",
+			Handler:    _ScoutService_ListOpenGames_Handler,
+		},
+		{
+			MethodName: "JoinGame",
+			Handler:    _ScoutService_JoinGame_Handler,
+		},
+		{
+			MethodName: "LeaveGame",
+			Handler:    _ScoutService_LeaveGame_Handler,
+		},
+		{
+			MethodName: "SetReady",
+			Handler:    _ScoutService_SetReady_Handler,
+		},
+		{
+			MethodName: "ListGames",
+			Handler:    _ScoutService_ListGames_Handler,
+		},
+		{
+			MethodName: "DeleteGame",
+			Handler:    _ScoutService_DeleteGame_Handler,
+		},
+		{
+			MethodName: "ExportGame",
+			Handler:    _ScoutService_ExportGame_Handler,
+		},
+		{
+			MethodName: "ImportGame",
+			Handler:    _ScoutService_ImportGame_Handler,
+		},
+		{
+			MethodName: "CreateGameFromState",
+			Handler:    _ScoutService_CreateGameFromState_Handler,
+		},
+		{
+			MethodName: "ExportGameRecord",
+			Handler:    _ScoutService_ExportGameRecord_Handler,
+		},
+		{
+			MethodName: "ImportGameRecord",
+			Handler:    _ScoutService_ImportGameRecord_Handler,
+		},
+		{
+			MethodName: "ExplainAction",
+			Handler:    _ScoutService_ExplainAction_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchGame",
+			Handler:       _ScoutService_WatchGame_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "PlaySession",
+			Handler:       _ScoutService_PlaySession_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "proto/scout.proto",
+}
+
+const (
+	AgentService_ChooseAction_FullMethodName = "/scout.AgentService/ChooseAction"
+)
+
+// AgentServiceClient is the client API for AgentService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// AgentService is implemented by external agents; the server calls it for seats
+// registered with RegisterAgent
+type AgentServiceClient interface {
+	ChooseAction(ctx context.Context, in *ChooseActionRequest, opts ...grpc.CallOption) (*ChooseActionResponse, error)
+}
+
+type agentServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAgentServiceClient(cc grpc.ClientConnInterface) AgentServiceClient {
+	return &agentServiceClient{cc}
+}
+
+func (c *agentServiceClient) ChooseAction(ctx context.Context, in *ChooseActionRequest, opts ...grpc.CallOption) (*ChooseActionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChooseActionResponse)
+	err := c.cc.Invoke(ctx, AgentService_ChooseAction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AgentServiceServer is the server API for AgentService service.
+// All implementations must embed UnimplementedAgentServiceServer
+// for forward compatibility.
+//
+// AgentService is implemented by external agents; the server calls it for seats
+// registered with RegisterAgent
+type AgentServiceServer interface {
+	ChooseAction(context.Context, *ChooseActionRequest) (*ChooseActionResponse, error)
+	mustEmbedUnimplementedAgentServiceServer()
+}
+
+// UnimplementedAgentServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAgentServiceServer struct{}
+
+func (UnimplementedAgentServiceServer) ChooseAction(context.Context, *ChooseActionRequest) (*ChooseActionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ChooseAction not implemented")
+}
+func (UnimplementedAgentServiceServer) mustEmbedUnimplementedAgentServiceServer() {}
+func (UnimplementedAgentServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafeAgentServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AgentServiceServer will
+// result in compilation errors.
+type UnsafeAgentServiceServer interface {
+	mustEmbedUnimplementedAgentServiceServer()
+}
+
+func RegisterAgentServiceServer(s grpc.ServiceRegistrar, srv AgentServiceServer) {
+	// If the following call panics, it indicates UnimplementedAgentServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AgentService_ServiceDesc, srv)
+}
+
+func _AgentService_ChooseAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChooseActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServiceServer).ChooseAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AgentService_ChooseAction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServiceServer).ChooseAction(ctx, req.(*ChooseActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AgentService_ServiceDesc is the grpc.ServiceDesc for AgentService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AgentService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "scout.AgentService",
+	HandlerType: (*AgentServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ChooseAction",
+			Handler:    _AgentService_ChooseAction_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/scout.proto",
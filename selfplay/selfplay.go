@@ -0,0 +1,200 @@
+// Package selfplay plays games between built-in policies and records every step as
+// training data.
+package selfplay
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sync"
+
+	"scout-go/server"
+)
+
+type Config struct {
+	Policies      []string // one per seat
+	Games         int
+	GamesPerShard int
+	Workers       int   // defaults to the number of CPUs
+	Seed          int64 // game i is dealt from Seed+i
+	Format        string
+	OutDir        string
+}
+
+// Step is one action taken in a game, from the acting player's point of view
+type Step struct {
+	Game        int       `json:"game"`
+	Seed        int64     `json:"seed"`
+	Step        int       `json:"step"`
+	Player      int       `json:"player"`
+	Observation []float32 `json:"obs"`
+	Legal       []int     `json:"legal_actions"` // IDs of the actions allowed by the mask
+	Action      int       `json:"action"`
+	Rewards     []float32 `json:"rewards"` // change in every player's score caused by the action
+	Done        bool      `json:"done"`
+}
+
+type Stats struct {
+	Games   int
+	Aborted int
+	Steps   int
+	Shards  []string
+}
+
+// Run plays cfg.Games games across cfg.Workers goroutines; each shard of
+// cfg.GamesPerShard games is written to its own file in cfg.OutDir
+func Run(cfg Config) (*Stats, error) {
+	n := len(cfg.Policies)
+	if n < 2 || n > 5 {
+		return nil, fmt.Errorf("selfplay needs between 2 and 5 policies, got %d", n)
+	}
+	if cfg.Games < 1 || cfg.GamesPerShard < 1 {
+		return nil, fmt.Errorf("games and games per shard must be positive")
+	}
+	if _, ok := writers[cfg.Format]; !ok {
+		return nil, fmt.Errorf("unknown format %q", cfg.Format)
+	}
+	if cfg.Workers < 1 {
+		cfg.Workers = runtime.NumCPU()
+	}
+
+	policies := make([]server.Policy, n)
+	for i, name := range cfg.Policies {
+		p, err := server.NewPolicy(name)
+		if err != nil {
+			return nil, err
+		}
+		policies[i] = p
+	}
+
+	if err := os.MkdirAll(cfg.OutDir, 0o755); err != nil {
+		return nil, err
+	}
+
+	numShards := (cfg.Games + cfg.GamesPerShard - 1) / cfg.GamesPerShard
+	shards := make(chan int)
+	results := make([]shardResult, numShards)
+
+	var wg sync.WaitGroup
+	for w := 0; w < cfg.Workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for shard := range shards {
+				results[shard] = runShard(cfg, policies, shard)
+			}
+		}()
+	}
+	for shard := 0; shard < numShards; shard++ {
+		shards <- shard
+	}
+	close(shards)
+	wg.Wait()
+
+	stats := &Stats{}
+	for _, r := range results {
+		if r.err != nil {
+			return nil, r.err
+		}
+		stats.Games += r.games
+		stats.Aborted += r.aborted
+		stats.Steps += r.steps
+		stats.Shards = append(stats.Shards, r.path)
+	}
+	return stats, nil
+}
+
+type shardResult struct {
+	path                  string
+	games, aborted, steps int
+	err                   error
+}
+
+func runShard(cfg Config, policies []server.Policy, shard int) shardResult {
+	path := filepath.Join(cfg.OutDir, fmt.Sprintf("shard-%05d.%s", shard, cfg.Format))
+	result := shardResult{path: path}
+
+	w, err := writers[cfg.Format](path, len(policies))
+	if err != nil {
+		result.err = err
+		return result
+	}
+
+	first := shard * cfg.GamesPerShard
+	last := min(first+cfg.GamesPerShard, cfg.Games)
+	for i := first; i < last && result.err == nil; i++ {
+		steps, ok := playGame(policies, i, cfg.Seed+int64(i))
+		if !ok {
+			result.aborted++
+			continue
+		}
+		for _, step := range steps {
+			if result.err = w.WriteStep(step); result.err != nil {
+				break
+			}
+		}
+		result.games++
+		result.steps += len(steps)
+	}
+
+	// a shard that failed part way is removed, so it can't pass for a whole one
+	if err := w.Close(); result.err == nil {
+		result.err = err
+	}
+	if result.err != nil {
+		os.Remove(path)
+	}
+	return result
+}
+
+// playGame plays one game to completion and returns every step taken
+func playGame(policies []server.Policy, gameIndex int, seed int64) ([]*Step, bool) {
+	game, err := server.NewSeededGame(len(policies), seed)
+	if err != nil {
+		return nil, false
+	}
+	r := rand.New(rand.NewPCG(uint64(seed), 1))
+
+	// every seat's score before each step; a step's rewards are the change up to the next one
+	steps := make([]*Step, 0)
+	before := make([][]int, 0)
+	ok := server.PlayOut(game, policies, r, func(seat int, legal []server.ActionSpec, action server.ActionSpec) {
+		step := &Step{
+			Game:        gameIndex,
+			Seed:        seed,
+			Step:        len(steps),
+			Player:      seat,
+			Observation: game.Observation(seat),
+			Legal:       make([]int, len(legal)),
+			Action:      action.ID,
+			Rewards:     make([]float32, len(policies)),
+		}
+		for i, legalAction := range legal {
+			step.Legal[i] = legalAction.ID
+		}
+		steps = append(steps, step)
+		before = append(before, scores(game))
+	})
+	if !ok {
+		return nil, false
+	}
+
+	before = append(before, scores(game))
+	for n, step := range steps {
+		for i := range step.Rewards {
+			step.Rewards[i] = float32(before[n+1][i] - before[n][i])
+		}
+	}
+	steps[len(steps)-1].Done = true
+	return steps, true
+}
+
+func scores(g *server.Game) []int {
+	s := make([]int, len(g.Players))
+	for i, p := range g.Players {
+		s[i] = p.Score
+	}
+	return s
+}
@@ -0,0 +1,138 @@
+package selfplay
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"scout-go/server"
+)
+
+func TestRunJSONL(t *testing.T) {
+	dir := t.TempDir()
+	stats, err := Run(Config{
+		Policies:      []string{"greedy", "random", "random"},
+		Games:         3,
+		GamesPerShard: 2,
+		Workers:       2,
+		Seed:          5,
+		Format:        "jsonl",
+		OutDir:        dir,
+	})
+	if err != nil {
+		t.Fatalf("Run returned err: %v", err)
+	}
+	if len(stats.Shards) != 2 {
+		t.Fatalf("expected 2 shards, got %d", len(stats.Shards))
+	}
+
+	steps := 0
+	dones := 0
+	for _, path := range stats.Shards {
+		f, err := os.Open(path)
+		if err != nil {
+			t.Fatalf("failed to open shard: %v", err)
+		}
+		scanner := bufio.NewScanner(f)
+		scanner.Buffer(nil, 1<<24)
+		for scanner.Scan() {
+			var step Step
+			if err := json.Unmarshal(scanner.Bytes(), &step); err != nil {
+				t.Fatalf("failed to decode step: %v", err)
+			}
+			if len(step.Observation) != server.OBSERVATION_SIZE {
+				t.Fatalf("expected observation of %d, got %d", server.OBSERVATION_SIZE, len(step.Observation))
+			}
+			if !slices.Contains(step.Legal, step.Action) {
+				t.Fatalf("game %d step %d: action %d is not in the mask", step.Game, step.Step, step.Action)
+			}
+			if step.Done {
+				dones++
+			}
+			steps++
+		}
+		f.Close()
+	}
+	if steps != stats.Steps {
+		t.Fatalf("expected %d steps, read %d", stats.Steps, steps)
+	}
+	if dones != stats.Games {
+		t.Fatalf("expected %d finished games, got %d", stats.Games, dones)
+	}
+}
+
+func TestNPYHeaderIsAligned(t *testing.T) {
+	for _, shape := range [][]int{{3}, {12, server.OBSERVATION_SIZE}, {0, 5}} {
+		header := npyHeader("<f4", shape)
+		if len(header)%64 != 0 {
+			t.Fatalf("header for shape %v is %d bytes, expected a multiple of 64", shape, len(header))
+		}
+		if header[len(header)-1] != '\n' {
+			t.Fatalf("header for shape %v does not end in a newline", shape)
+		}
+	}
+}
+
+func TestRunNPZ(t *testing.T) {
+	dir := t.TempDir()
+	stats, err := Run(Config{
+		Policies:      []string{"greedy", "greedy"},
+		Games:         2,
+		GamesPerShard: 2,
+		Seed:          1,
+		Format:        "npz",
+		OutDir:        dir,
+	})
+	if err != nil {
+		t.Fatalf("Run returned err: %v", err)
+	}
+	if stats.Shards[0] != filepath.Join(dir, "shard-00000.npz") {
+		t.Fatalf("unexpected shard path %s", stats.Shards[0])
+	}
+	if _, err := os.Stat(stats.Shards[0]); err != nil {
+		t.Fatalf("shard was not written: %v", err)
+	}
+}
+
+// failingWriter writes a shard's file, then fails on its first step
+type failingWriter struct {
+	*jsonlWriter
+	closed *bool
+}
+
+func (w failingWriter) WriteStep(step *Step) error {
+	return errors.New("disk full")
+}
+
+func (w failingWriter) Close() error {
+	*w.closed = true
+	return w.jsonlWriter.Close()
+}
+
+func TestRunRemovesFailedShard(t *testing.T) {
+	closed := false
+	writers["failing"] = func(path string, numPlayers int) (stepWriter, error) {
+		w, err := newJSONLWriter(path, numPlayers)
+		if err != nil {
+			return nil, err
+		}
+		return failingWriter{w.(*jsonlWriter), &closed}, nil
+	}
+	defer delete(writers, "failing")
+
+	dir := t.TempDir()
+	_, err := Run(Config{Policies: []string{"greedy", "greedy"}, Games: 1, GamesPerShard: 1, Seed: 1, Format: "failing", OutDir: dir})
+	if err == nil {
+		t.Fatalf("expected Run to fail")
+	}
+	if !closed {
+		t.Fatalf("expected the shard to be closed")
+	}
+	if _, err := os.Stat(filepath.Join(dir, "shard-00000.failing")); !os.IsNotExist(err) {
+		t.Fatalf("expected the failed shard to be removed, got %v", err)
+	}
+}
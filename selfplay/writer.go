@@ -0,0 +1,172 @@
+package selfplay
+
+import (
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"scout-go/server"
+)
+
+type stepWriter interface {
+	WriteStep(step *Step) error
+	Close() error
+}
+
+var writers = map[string]func(path string, numPlayers int) (stepWriter, error){
+	"jsonl": newJSONLWriter,
+	"npz":   newNPZWriter,
+}
+
+// jsonlWriter writes one JSON object per step
+type jsonlWriter struct {
+	f   *os.File
+	buf *bufio.Writer
+	enc *json.Encoder
+}
+
+func newJSONLWriter(path string, numPlayers int) (stepWriter, error) {
+	f, err := os.Create(path)
+	if err != nil {
+		return nil, err
+	}
+	buf := bufio.NewWriter(f)
+	return &jsonlWriter{f: f, buf: buf, enc: json.NewEncoder(buf)}, nil
+}
+
+func (w *jsonlWriter) WriteStep(step *Step) error {
+	return w.enc.Encode(step)
+}
+
+func (w *jsonlWriter) Close() error {
+	if err := w.buf.Flush(); err != nil {
+		w.f.Close()
+		return err
+	}
+	return w.f.Close()
+}
+
+// npzWriter collects a shard's steps in memory and writes them as a NumPy .npz archive
+// on Close, with one array per field:
+//
+//	obs     float32 (N, OBSERVATION_SIZE)
+//	mask    uint8   (N, ceil(num_actions/8)), packed with np.packbits;
+//	        np.unpackbits(mask, axis=1)[:, :num_actions] recovers it
+//	action  int32   (N,)
+//	player  int32   (N,)
+//	reward  float32 (N, num_players)
+//	done    bool    (N,)
+//	game    int32   (N,)
+//	seed    int64   (N,)
+type npzWriter struct {
+	path       string
+	numPlayers int
+	steps      []*Step
+}
+
+func newNPZWriter(path string, numPlayers int) (stepWriter, error) {
+	return &npzWriter{path: path, numPlayers: numPlayers}, nil
+}
+
+func (w *npzWriter) WriteStep(step *Step) error {
+	w.steps = append(w.steps, step)
+	return nil
+}
+
+func (w *npzWriter) Close() error {
+	n := len(w.steps)
+	maskBytes := (len(server.AllActions()) + 7) / 8
+
+	obs := make([]float32, 0, n*server.OBSERVATION_SIZE)
+	mask := make([]uint8, n*maskBytes)
+	action := make([]int32, n)
+	player := make([]int32, n)
+	reward := make([]float32, 0, n*w.numPlayers)
+	done := make([]bool, n)
+	game := make([]int32, n)
+	seed := make([]int64, n)
+	for i, step := range w.steps {
+		obs = append(obs, step.Observation...)
+		for _, id := range step.Legal {
+			mask[i*maskBytes+id/8] |= 0x80 >> (id % 8)
+		}
+		action[i] = int32(step.Action)
+		player[i] = int32(step.Player)
+		reward = append(reward, step.Rewards...)
+		done[i] = step.Done
+		game[i] = int32(step.Game)
+		seed[i] = step.Seed
+	}
+
+	f, err := os.Create(w.path)
+	if err != nil {
+		return err
+	}
+	z := zip.NewWriter(f)
+	arrays := []struct {
+		name  string
+		descr string
+		shape []int
+		data  any
+	}{
+		{"obs", "<f4", []int{n, server.OBSERVATION_SIZE}, obs},
+		{"mask", "|u1", []int{n, maskBytes}, mask},
+		{"action", "<i4", []int{n}, action},
+		{"player", "<i4", []int{n}, player},
+		{"reward", "<f4", []int{n, w.numPlayers}, reward},
+		{"done", "|b1", []int{n}, done},
+		{"game", "<i4", []int{n}, game},
+		{"seed", "<i8", []int{n}, seed},
+	}
+	for _, a := range arrays {
+		entry, err := z.Create(a.name + ".npy")
+		if err != nil {
+			f.Close()
+			return err
+		}
+		if _, err := entry.Write(npyHeader(a.descr, a.shape)); err != nil {
+			f.Close()
+			return err
+		}
+		if err := binary.Write(entry, binary.LittleEndian, a.data); err != nil {
+			f.Close()
+			return err
+		}
+	}
+	if err := z.Close(); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// npyHeader returns the header of a version 1.0 .npy file
+func npyHeader(descr string, shape []int) []byte {
+	dims := make([]string, len(shape))
+	for i, d := range shape {
+		dims[i] = fmt.Sprint(d)
+	}
+	shapeStr := strings.Join(dims, ", ")
+	if len(shape) == 1 {
+		shapeStr += ","
+	}
+	dict := fmt.Sprintf("{'descr': '%s', 'fortran_order': False, 'shape': (%s), }", descr, shapeStr)
+
+	// magic (6) + version (2) + header length (2) + dict + padding + newline, aligned to 64
+	total := 10 + len(dict) + 1
+	padding := (64 - total%64) % 64
+
+	var buf bytes.Buffer
+	buf.WriteString("\x93NUMPY")
+	buf.Write([]byte{1, 0})
+	binary.Write(&buf, binary.LittleEndian, uint16(len(dict)+padding+1))
+	buf.WriteString(dict)
+	buf.WriteString(strings.Repeat(" ", padding))
+	buf.WriteByte('\n')
+	return buf.Bytes()
+}
@@ -1,9 +1,5 @@
 package server
 
-import (
-	"fmt"
-)
-
 // scoutAction lets the active player take a card from the active set and place it in their hand.
 // params are (takeIndex, putIndex), where takeIndex is the index of the card in the active set to take,
 // and putIndex is the index in the player's hand to insert the taken card.
@@ -11,6 +7,7 @@ func (g *Game) scoutAction(takeIndex, putIndex int) RulesViolation {
 	p := g.ActivePlayer
 
 	card := g.ActiveSet[takeIndex]
+	card.Public = true
 
 	// add to player's hand
 	p.Hand = append(p.Hand[:putIndex], append([]*Card{card}, p.Hand[putIndex:]...)...)
@@ -40,6 +37,7 @@ func (g *Game) scoutActionReverse(takeIndex, putIndex int) RulesViolation {
 	p := g.ActivePlayer
 
 	card := g.ActiveSet[takeIndex]
+	card.Public = true
 
 	card.ReverseValues()
 
@@ -73,7 +71,7 @@ func (g *Game) showAction(firstIndex, length int) RulesViolation {
 	p := g.ActivePlayer
 
 	if firstIndex < 0 || firstIndex+length > len(p.Hand) {
-		return RulesViolation(fmt.Errorf("index out of range"))
+		return errIndexOutOfRange
 	}
 	set := p.Hand[firstIndex : firstIndex+length]
 
@@ -1,5 +1,7 @@
 package server
 
+import "sync"
+
 const (
 	ActionScout ActionType = iota
 	ActionScoutReverse
@@ -14,6 +16,16 @@ const MAX_ACTIVE_SET_SIZE = 10 // straight 1-10
 
 type ActionType int
 
+// names of action types in logs and metric labels, indexed by ActionType
+var actionTypeNames = []string{"scout", "scout_reverse", "show", "scout_and_show", "scout_and_show_reverse", "reverse_hand"}
+
+func (t ActionType) String() string {
+	if t < 0 || int(t) >= len(actionTypeNames) {
+		return "unknown"
+	}
+	return actionTypeNames[t]
+}
+
 type ActionSpec struct {
 	ID                            int
 	Type                          ActionType
@@ -21,6 +33,20 @@ type ActionSpec struct {
 	ShowFirstIndex, ShowLength    int // show action params
 }
 
+var (
+	allActions     []ActionSpec
+	allActionsOnce sync.Once
+)
+
+// AllActions returns every action in the action space, indexed by ID. the slice is shared;
+// callers must not modify it.
+func AllActions() []ActionSpec {
+	allActionsOnce.Do(func() {
+		allActions = getAllActions()
+	})
+	return allActions
+}
+
 func getAllActions() []ActionSpec {
 	actions := make([]ActionSpec, 0)
 	scoutActions := make([]ActionSpec, 0)
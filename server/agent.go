@@ -0,0 +1,205 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math/rand/v2"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	pb "scout-go/proto"
+)
+
+const (
+	DEFAULT_AGENT_TIMEOUT   = 5 * time.Second
+	DEFAULT_FALLBACK_POLICY = "greedy"
+)
+
+// agentSeat is a seat played by an external AgentService
+type agentSeat struct {
+	address  string
+	conn     *grpc.ClientConn
+	client   pb.AgentServiceClient
+	timeout  time.Duration
+	fallback Policy
+}
+
+// gameAgents holds the agents registered for one game
+type gameAgents struct {
+	mu      sync.Mutex
+	seats   map[int]*agentSeat
+	driving bool // a driveAgents goroutine is running for the game
+}
+
+// WithAgentDefaults sets how long agents registered without a timeout get to choose, and the
+// policy that plays for them when they fail, if they name none
+func WithAgentDefaults(timeout time.Duration, fallbackPolicy string) Option {
+	return func(s *ScoutServer) {
+		s.agentTimeout = timeout
+		s.agentFallback = fallbackPolicy
+	}
+}
+
+func (s *ScoutServer) RegisterAgent(ctx context.Context, req *pb.RegisterAgentRequest) (*pb.RegisterAgentResponse, error) {
+	game := s.store.Get(req.GameId)
+
+	if game == nil {
+		return nil, errInvalidGameId
+	}
+	if int(req.PlayerIndex) < 0 || int(req.PlayerIndex) >= len(game.Players) {
+		return nil, errInvalidPlayerIndex
+	}
+	if req.Address == "" {
+		return nil, fmt.Errorf("address is required")
+	}
+
+	timeout := s.agentTimeout
+	if req.TimeoutMs > 0 {
+		timeout = time.Duration(req.TimeoutMs) * time.Millisecond
+	}
+	fallbackName := req.FallbackPolicy
+	if fallbackName == "" {
+		fallbackName = s.agentFallback
+	}
+	fallback, err := NewPolicy(fallbackName)
+	if err != nil {
+		return nil, err
+	}
+
+	// an agent can take an empty seat in the lobby, ready to play; any other seat has to be
+	// handed over by whoever holds it
+	resp := &pb.RegisterAgentResponse{}
+	if game.Phase() == PhaseLobby && !game.hasSeatToken(int(req.PlayerIndex)) {
+		if err := game.TakeSeat(int(req.PlayerIndex), "agent"); err != nil {
+			return nil, violationStatus(err)
+		}
+		resp.SeatToken = game.SeatToken(int(req.PlayerIndex))
+		s.giveSeat(ctx, game, int(req.PlayerIndex))
+	} else if err := s.authorizeSeat(ctx, game, int(req.PlayerIndex)); err != nil {
+		return nil, err
+	}
+	if game.Phase() == PhaseLobby {
+		if _, err := game.SetReady(int(req.PlayerIndex), true); err != nil {
+			return nil, violationStatus(err)
+		}
+	}
+
+	conn, err := grpc.NewClient(req.Address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("invalid agent address: %v", err)
+	}
+	seat := &agentSeat{
+		address:  req.Address,
+		conn:     conn,
+		client:   pb.NewAgentServiceClient(conn),
+		timeout:  timeout,
+		fallback: fallback,
+	}
+
+	s.mu.Lock()
+	agents := s.agents[game.Id]
+	if agents == nil {
+		agents = &gameAgents{seats: make(map[int]*agentSeat)}
+		s.agents[game.Id] = agents
+	}
+	s.mu.Unlock()
+
+	agents.mu.Lock()
+	if old := agents.seats[int(req.PlayerIndex)]; old != nil {
+		old.conn.Close()
+	}
+	agents.seats[int(req.PlayerIndex)] = seat
+	agents.mu.Unlock()
+
+	s.gameChanged(game)
+
+	return resp, nil
+}
+
+// driveAgents plays the game for as long as the active seat belongs to an agent. it returns
+// immediately; the moves are made on a separate goroutine, at most one per game.
+func (s *ScoutServer) driveAgents(game *Game) {
+	s.mu.RLock()
+	agents := s.agents[game.Id]
+	s.mu.RUnlock()
+
+	if agents == nil {
+		return
+	}
+
+	agents.mu.Lock()
+	defer agents.mu.Unlock()
+	if agents.driving {
+		return
+	}
+	agents.driving = true
+
+	go func() {
+		r := rand.New(rand.NewPCG(rand.Uint64(), rand.Uint64()))
+		for {
+			seatIndex, phase := game.turn()
+
+			agents.mu.Lock()
+			seat := agents.seats[seatIndex]
+			if phase == PhaseComplete {
+				for _, a := range agents.seats {
+					a.conn.Close()
+				}
+				agents.seats = make(map[int]*agentSeat)
+			}
+			// lobby games are driven again once they start
+			if phase != PhasePlaying || seat == nil {
+				agents.driving = false
+				agents.mu.Unlock()
+				return
+			}
+			agents.mu.Unlock()
+
+			action, ok := s.agentAction(game, seatIndex, seat, r)
+			if !ok {
+				continue
+			}
+			if err := game.PlayerAction(seatIndex, &action); err != nil {
+				// the turn moved on underneath us; look again
+				slog.Warn("agent action rejected", "game_id", game.Id, "player_index", seatIndex, "err", err)
+			} else {
+				s.save(game)
+			}
+		}
+	}()
+}
+
+// agentAction asks the seat's agent for an action, falling back to the seat's built-in
+// policy if the agent fails, times out, or picks an action the mask does not allow.
+// it returns false if the seat has nothing to play, e.g. because the turn moved on.
+func (s *ScoutServer) agentAction(game *Game, seatIndex int, seat *agentSeat, r *rand.Rand) (ActionSpec, bool) {
+	mask := s.actionMask(game, seatIndex)
+
+	ctx, cancel := context.WithTimeout(context.Background(), seat.timeout)
+	defer cancel()
+	resp, err := seat.client.ChooseAction(ctx, &pb.ChooseActionRequest{
+		GameId:      game.Id,
+		PlayerIndex: int32(seatIndex),
+		Observation: game.ObservationProto(seatIndex),
+		Mask:        mask,
+	})
+
+	switch {
+	case err != nil:
+		slog.Warn("agent failed, using fallback", "game_id", game.Id, "player_index", seatIndex, "agent", seat.address, "fallback", seat.fallback.Name(), "err", err)
+	case resp.ActionId < 0 || int(resp.ActionId) >= len(mask) || !mask[resp.ActionId]:
+		slog.Warn("agent chose invalid action, using fallback", "game_id", game.Id, "player_index", seatIndex, "agent", seat.address, "action_id", resp.ActionId, "fallback", seat.fallback.Name())
+	default:
+		return AllActions()[resp.ActionId], true
+	}
+
+	legal := game.LegalActions(seatIndex)
+	if len(legal) == 0 {
+		return ActionSpec{}, false
+	}
+	return seat.fallback.ChooseAction(game, seatIndex, legal, r), true
+}
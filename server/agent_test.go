@@ -0,0 +1,102 @@
+package server
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+
+	pb "scout-go/proto"
+)
+
+// standInAgent plays the first action its mask allows, after an optional delay
+type standInAgent struct {
+	pb.UnimplementedAgentServiceServer
+	delay time.Duration
+	calls chan int32
+}
+
+func (a *standInAgent) ChooseAction(ctx context.Context, req *pb.ChooseActionRequest) (*pb.ChooseActionResponse, error) {
+	a.calls <- req.PlayerIndex
+	select {
+	case <-time.After(a.delay):
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	for id, ok := range req.Mask {
+		if ok {
+			return &pb.ChooseActionResponse{ActionId: int32(id)}, nil
+		}
+	}
+	return &pb.ChooseActionResponse{ActionId: -1}, nil
+}
+
+func startAgent(t *testing.T, agent *standInAgent) string {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+	s := grpc.NewServer()
+	pb.RegisterAgentServiceServer(s, agent)
+	go s.Serve(lis)
+	t.Cleanup(s.Stop)
+	return lis.Addr().String()
+}
+
+func waitForCompletion(t *testing.T, game *Game) {
+	deadline := time.Now().Add(10 * time.Second)
+	for time.Now().Before(deadline) {
+		if _, phase := game.turn(); phase == PhaseComplete {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatalf("game did not complete")
+}
+
+func TestAgentsPlayGame(t *testing.T) {
+	agent := &standInAgent{calls: make(chan int32, 1000)}
+	addr := startAgent(t, agent)
+
+	s := NewScoutServer()
+	ctx := context.Background()
+	created, err := s.CreateGame(ctx, &pb.CreateGameRequest{NumPlayers: 2})
+	if err != nil {
+		t.Fatalf("CreateGame returned err: %v", err)
+	}
+	for seat := int32(0); seat < 2; seat++ {
+		_, err := s.RegisterAgent(asSeat(created.SeatTokens[seat]), &pb.RegisterAgentRequest{GameId: created.GameId, PlayerIndex: seat, Address: addr})
+		if err != nil {
+			t.Fatalf("RegisterAgent returned err: %v", err)
+		}
+	}
+
+	waitForCompletion(t, s.store.Get(created.GameId))
+	if len(agent.calls) == 0 {
+		t.Fatalf("agent was never called")
+	}
+}
+
+func TestAgentTimeoutFallsBack(t *testing.T) {
+	agent := &standInAgent{delay: time.Hour, calls: make(chan int32, 1000)}
+	addr := startAgent(t, agent)
+
+	s := NewScoutServer()
+	ctx := context.Background()
+	created, err := s.CreateGame(ctx, &pb.CreateGameRequest{NumPlayers: 2})
+	if err != nil {
+		t.Fatalf("CreateGame returned err: %v", err)
+	}
+	for seat := int32(0); seat < 2; seat++ {
+		_, err := s.RegisterAgent(asSeat(created.SeatTokens[seat]), &pb.RegisterAgentRequest{
+			GameId: created.GameId, PlayerIndex: seat, Address: addr, TimeoutMs: 20, FallbackPolicy: "random",
+		})
+		if err != nil {
+			t.Fatalf("RegisterAgent returned err: %v", err)
+		}
+	}
+
+	waitForCompletion(t, s.store.Get(created.GameId))
+}
@@ -0,0 +1,151 @@
+package server
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// metadata keys clients send their tokens in
+const (
+	SEAT_TOKEN_HEADER  = "x-seat-token"
+	ADMIN_TOKEN_HEADER = "x-admin-token"
+)
+
+const (
+	RoleSpectator Role = iota // no token; sees only what the table sees
+	RoleSeat                  // holds a seat token; acts for that seat only
+	RoleAdmin                 // holds the admin token; acts for any seat
+)
+
+// Role is what a caller is allowed to do
+type Role int
+
+// WithAdminToken sets the token that grants the admin role. without it no caller is an admin.
+func WithAdminToken(token string) Option {
+	return func(s *ScoutServer) {
+		s.adminToken = token
+	}
+}
+
+// SeatToken returns the token for a seat, or "" if the seat is empty. a game loaded from a
+// store knows only its tokens' hashes, so it returns "" for seats held before it was loaded.
+func (g *Game) SeatToken(playerIndex int) string {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	return g.tokens[playerIndex]
+}
+
+// seatTokens returns every seat's token, in seat order
+func (g *Game) seatTokens() []string {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	return append([]string(nil), g.tokens...)
+}
+
+// newSeatToken gives the seat a fresh token, revoking the old one and whoever held it.
+// must be called with g.mu held.
+func (g *Game) newSeatToken(playerIndex int) {
+	g.tokens[playerIndex] = uuid.New().String()
+	g.tokenHashes[playerIndex] = hashToken(g.tokens[playerIndex])
+	g.owners[playerIndex] = ""
+}
+
+// revokeSeatToken leaves the seat without a token. must be called with g.mu held.
+func (g *Game) revokeSeatToken(playerIndex int) {
+	g.tokens[playerIndex] = ""
+	g.tokenHashes[playerIndex] = ""
+	g.owners[playerIndex] = ""
+}
+
+// hasSeatToken reports whether anyone holds the seat's token
+func (g *Game) hasSeatToken(playerIndex int) bool {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	return g.tokenHashes[playerIndex] != ""
+}
+
+func (g *Game) checkSeatToken(playerIndex int, token string) bool {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	if playerIndex < 0 || playerIndex >= len(g.tokenHashes) || g.tokenHashes[playerIndex] == "" {
+		return false
+	}
+	return tokensEqual(g.tokenHashes[playerIndex], hashToken(token))
+}
+
+// role returns the caller's role in the game, and for RoleSeat, which seat it holds
+func (s *ScoutServer) role(ctx context.Context, game *Game) (Role, int) {
+	if s.isAdmin(ctx) {
+		return RoleAdmin, 0
+	}
+	if token := metadataValue(ctx, SEAT_TOKEN_HEADER); token != "" {
+		for i := range game.Players {
+			if game.checkSeatToken(i, token) {
+				return RoleSeat, i
+			}
+		}
+	}
+	return RoleSpectator, 0
+}
+
+// isAdmin reports whether the caller holds the admin token or an admin's client certificate
+func (s *ScoutServer) isAdmin(ctx context.Context) bool {
+	if s.adminIdentities[s.identities.Identify(ctx)] {
+		return true
+	}
+	return s.adminToken != "" && tokensEqual(s.adminToken, metadataValue(ctx, ADMIN_TOKEN_HEADER))
+}
+
+// identity names the caller for per-client limits: "client:<identity>" for a client certificate,
+// or "admin", and "" if it has neither. seat tokens don't name the caller: one client can hold
+// seats in many games, and its limits have to cover all of them.
+func (s *ScoutServer) identity(ctx context.Context) string {
+	if id := s.identities.Identify(ctx); id != "" {
+		return "client:" + id
+	}
+	if s.isAdmin(ctx) {
+		return "admin"
+	}
+	return ""
+}
+
+// authorizeSeat checks that the caller may act for the seat
+func (s *ScoutServer) authorizeSeat(ctx context.Context, game *Game, playerIndex int) error {
+	role, seat := s.role(ctx, game)
+	if role == RoleAdmin || (role == RoleSeat && seat == playerIndex) || s.ownsSeat(ctx, game, playerIndex) {
+		return nil
+	}
+	return status.Errorf(codes.PermissionDenied, "not authorized for seat %d", playerIndex)
+}
+
+func metadataValue(ctx context.Context, key string) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	if values := md.Get(key); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+// hashToken is what is kept of a seat token once it's handed out, so a store never holds one
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+func tokensEqual(a, b string) bool {
+	return subtle.ConstantTimeCompare([]byte(a), []byte(b)) == 1
+}
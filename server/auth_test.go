@@ -0,0 +1,102 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	pb "scout-go/proto"
+)
+
+// asSeat returns a context for calling the server directly as the holder of token
+func asSeat(token string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs(SEAT_TOKEN_HEADER, token))
+}
+
+// dialAsSeat returns a context for calling the server through a client as the holder of token
+func dialAsSeat(token string) context.Context {
+	return metadata.AppendToOutgoingContext(context.Background(), SEAT_TOKEN_HEADER, token)
+}
+
+func TestSeatTokens(t *testing.T) {
+	s := NewScoutServer(WithAdminToken("admin"))
+	ctx := context.Background()
+
+	created, err := s.CreateGame(ctx, &pb.CreateGameRequest{NumPlayers: 2})
+	if err != nil {
+		t.Fatalf("CreateGame returned err: %v", err)
+	}
+	if len(created.SeatTokens) != 2 || created.SeatTokens[0] == created.SeatTokens[1] {
+		t.Fatalf("expected a distinct token per seat, got %v", created.SeatTokens)
+	}
+
+	admin := metadata.NewIncomingContext(ctx, metadata.Pairs(ADMIN_TOKEN_HEADER, "admin"))
+	badAdmin := metadata.NewIncomingContext(ctx, metadata.Pairs(ADMIN_TOKEN_HEADER, "nimda"))
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		seat    int32
+		allowed bool
+	}{
+		{"no token", ctx, 0, false},
+		{"own seat", asSeat(created.SeatTokens[0]), 0, true},
+		{"other seat", asSeat(created.SeatTokens[1]), 0, false},
+		{"made up token", asSeat("not-a-token"), 0, false},
+		{"admin", admin, 1, true},
+		{"wrong admin token", badAdmin, 1, false},
+	}
+
+	for _, test := range tests {
+		_, err := s.GetPlayerState(test.ctx, &pb.GetPlayerStateRequest{GameId: created.GameId, PlayerIndex: test.seat})
+		if test.allowed && err != nil {
+			t.Fatalf("%s: GetPlayerState returned err: %v", test.name, err)
+		}
+		if !test.allowed && status.Code(err) != codes.PermissionDenied {
+			t.Fatalf("%s: expected PermissionDenied, got %v", test.name, err)
+		}
+
+		_, err = s.GetValidActions(test.ctx, &pb.GetValidActionsRequest{GameId: created.GameId, PlayerIndex: test.seat})
+		if !test.allowed && status.Code(err) != codes.PermissionDenied {
+			t.Fatalf("%s: expected PermissionDenied from GetValidActions, got %v", test.name, err)
+		}
+		_, err = s.PlayerAction(test.ctx, &pb.PlayerActionRequest{GameId: created.GameId, PlayerIndex: test.seat, Action: &pb.Action{}})
+		if !test.allowed && status.Code(err) != codes.PermissionDenied {
+			t.Fatalf("%s: expected PermissionDenied from PlayerAction, got %v", test.name, err)
+		}
+	}
+
+	// spectators can still see the table
+	if _, err := s.GetGameState(ctx, &pb.GetGameStateRequest{GameId: created.GameId}); err != nil {
+		t.Fatalf("GetGameState returned err: %v", err)
+	}
+}
+
+func TestLeavingRevokesSeatToken(t *testing.T) {
+	s := NewScoutServer()
+	ctx := context.Background()
+
+	created, _ := s.CreateGame(ctx, &pb.CreateGameRequest{NumPlayers: 2, Open: true})
+	if len(created.SeatTokens) != 0 {
+		t.Fatalf("expected no tokens for an open game, got %v", created.SeatTokens)
+	}
+	joined, err := s.JoinGame(ctx, &pb.JoinGameRequest{GameId: created.GameId})
+	if err != nil {
+		t.Fatalf("JoinGame returned err: %v", err)
+	}
+	if _, err := s.LeaveGame(asSeat(joined.SeatToken), &pb.LeaveGameRequest{GameId: created.GameId, PlayerIndex: joined.PlayerIndex}); err != nil {
+		t.Fatalf("LeaveGame returned err: %v", err)
+	}
+
+	rejoined, _ := s.JoinGame(ctx, &pb.JoinGameRequest{GameId: created.GameId})
+	if rejoined.PlayerIndex != joined.PlayerIndex || rejoined.SeatToken == joined.SeatToken {
+		t.Fatalf("expected the seat to be reissued with a new token")
+	}
+	_, err = s.SetReady(asSeat(joined.SeatToken), &pb.SetReadyRequest{GameId: created.GameId, PlayerIndex: joined.PlayerIndex, Ready: true})
+	if status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("expected the old token to be revoked, got %v", err)
+	}
+}
@@ -5,6 +5,7 @@ import "fmt"
 type Card struct {
 	Value1 int
 	Value2 int
+	Public bool // true once the card has been seen by every seat (e.g. scouted into a hand)
 }
 
 func NewCard(value1, value2 int) (*Card, error) {
@@ -0,0 +1,110 @@
+package server
+
+import (
+	"log/slog"
+	"math/rand/v2"
+	"time"
+)
+
+// timed reports whether the game has time controls
+func (g *Game) timed() bool {
+	return g.Config.TurnTime > 0 || g.Config.TotalTime > 0
+}
+
+// startTurn starts the active seat's clock. must be called with g.mu held.
+func (g *Game) startTurn() {
+	if g.timer != nil {
+		g.timer.Stop()
+		g.timer = nil
+	}
+	g.turns++
+	g.turnStarted = time.Now()
+	if !g.timed() || g.closed {
+		return
+	}
+
+	turn := g.turns
+	g.timer = time.AfterFunc(g.turnLimit(g.ActivePlayer.Index), func() { g.turnExpired(turn) })
+}
+
+// stopTurn stops the active seat's clock, charging the turn to its total time. must be
+// called with g.mu held.
+func (g *Game) stopTurn() {
+	if g.timer != nil {
+		g.timer.Stop()
+		g.timer = nil
+	}
+	if g.Config.TotalTime > 0 {
+		seat := g.ActivePlayer.Index
+		g.clocks[seat] = max(g.clocks[seat]-time.Since(g.turnStarted), 0)
+	}
+}
+
+// turnLimit returns how long the seat has for a whole turn
+func (g *Game) turnLimit(seat int) time.Duration {
+	limit := g.Config.TurnTime
+	if g.Config.TotalTime > 0 && (limit == 0 || g.clocks[seat] < limit) {
+		limit = g.clocks[seat]
+	}
+	return limit
+}
+
+// timeLeft returns how long the seat has left for the game, and for the turn if it is the
+// active seat. must be called with g.mu held.
+func (g *Game) timeLeft(seat int) (total, turn time.Duration) {
+	if g.Config.TotalTime > 0 {
+		total = g.clocks[seat]
+	}
+	if g.phase() != PhasePlaying || seat != g.ActivePlayer.Index || !g.timed() {
+		return total, 0
+	}
+
+	elapsed := time.Since(g.turnStarted)
+	if g.Config.TotalTime > 0 {
+		total = max(total-elapsed, 0)
+	}
+	return total, max(g.turnLimit(seat)-elapsed, 0)
+}
+
+// turnExpired plays the timeout policy's move for a seat that ran out of time, unless the
+// seat moved in the meantime
+func (g *Game) turnExpired(turn int64) {
+	g.mu.RLock()
+	current := g.turns == turn && g.phase() == PhasePlaying
+	seat := g.ActivePlayer.Index
+	policy, _ := NewPolicy(g.Config.TimeoutPolicy)
+	g.mu.RUnlock()
+
+	if !current || policy == nil {
+		return
+	}
+
+	// the move has to end the turn, so reversing the hand is out
+	legal := make([]ActionSpec, 0)
+	for _, action := range g.LegalActions(seat) {
+		if action.Type != ActionReverseHand {
+			legal = append(legal, action)
+		}
+	}
+	if len(legal) == 0 {
+		return
+	}
+	action := policy.ChooseAction(g, seat, legal, rand.New(rand.NewPCG(rand.Uint64(), rand.Uint64())))
+
+	g.mu.Lock()
+	if g.turns != turn {
+		g.mu.Unlock()
+		return
+	}
+	err := g.playerAction(seat, &action, true)
+	afterTimeout := g.afterTimeout
+	g.mu.Unlock()
+
+	if err != nil {
+		slog.Warn("timeout action rejected", "game_id", g.Id, "player_index", seat, "err", err)
+		return
+	}
+	if afterTimeout != nil {
+		afterTimeout()
+	}
+}
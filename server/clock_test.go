@@ -0,0 +1,127 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	pb "scout-go/proto"
+)
+
+func TestTimeoutsPlayStuckSeats(t *testing.T) {
+	tests := []struct {
+		name   string
+		config *pb.GameConfig
+	}{
+		{"turn time", &pb.GameConfig{TurnTimeMs: proto.Int64(5), TimeoutPolicy: proto.String("random")}},
+		{"total time", &pb.GameConfig{TotalTimeMs: proto.Int64(20)}},
+	}
+
+	for _, test := range tests {
+		s := NewScoutServer()
+		created, err := s.CreateGame(context.Background(), &pb.CreateGameRequest{NumPlayers: 2, Config: test.config})
+		if err != nil {
+			t.Fatalf("%s: CreateGame returned err: %v", test.name, err)
+		}
+
+		// nobody plays, so the clocks play the whole game
+		game := s.store.Get(created.GameId)
+		waitForCompletion(t, game)
+
+		game.mu.RLock()
+		for i, move := range game.History {
+			if !move.Timeout {
+				t.Fatalf("%s: move %d was not a timeout", test.name, i)
+			}
+		}
+		game.mu.RUnlock()
+
+		state := game.ToProto()
+		for _, player := range state.PlayerStates {
+			if player.Timeouts == 0 {
+				t.Fatalf("%s: expected seat %d to have timed out", test.name, player.PlayerIndex)
+			}
+			if test.config.GetTotalTimeMs() > 0 && player.TimeRemainingMs != 0 {
+				t.Fatalf("%s: expected seat %d to have no time left, got %dms", test.name, player.PlayerIndex, player.TimeRemainingMs)
+			}
+		}
+	}
+}
+
+func TestTimeRemaining(t *testing.T) {
+	s := NewScoutServer()
+	ctx := context.Background()
+	config := &pb.GameConfig{TurnTimeMs: proto.Int64(time.Minute.Milliseconds()), TotalTimeMs: proto.Int64(time.Hour.Milliseconds())}
+	created, err := s.CreateGame(ctx, &pb.CreateGameRequest{NumPlayers: 3, Config: config})
+	if err != nil {
+		t.Fatalf("CreateGame returned err: %v", err)
+	}
+
+	resp, _ := s.PlayerAction(asSeat(created.SeatTokens[0]), &pb.PlayerActionRequest{
+		GameId: created.GameId, PlayerIndex: 0, Action: &pb.Action{ActionType: pb.Action_ActionShow, ShowLength: 1},
+	})
+	if resp.Err {
+		t.Fatalf("show failed: %s", resp.ErrMsg)
+	}
+
+	state, _ := s.GetGameState(ctx, &pb.GetGameStateRequest{GameId: created.GameId})
+	if remaining := state.Game.TurnTimeRemainingMs; remaining <= 0 || remaining > config.GetTurnTimeMs() {
+		t.Fatalf("expected the turn to have under a minute left, got %dms", remaining)
+	}
+	for _, player := range state.Game.PlayerStates {
+		if player.TimeRemainingMs <= 0 || player.TimeRemainingMs > config.GetTotalTimeMs() {
+			t.Fatalf("expected seat %d to have under an hour left, got %dms", player.PlayerIndex, player.TimeRemainingMs)
+		}
+		if player.Timeouts != 0 {
+			t.Fatalf("expected no timeouts, got %d", player.Timeouts)
+		}
+	}
+	game := s.store.Get(created.GameId)
+	if len(game.History) != 1 || game.History[0].Timeout {
+		t.Fatalf("expected one move that was not a timeout, got %v", game.History)
+	}
+}
+
+func TestInvalidTimeControls(t *testing.T) {
+	configs := []*pb.GameConfig{
+		{TurnTimeMs: proto.Int64(-1)},
+		{TotalTimeMs: proto.Int64(-1)},
+		{TurnTimeMs: proto.Int64(1000), TimeoutPolicy: proto.String("nonexistent")},
+	}
+	for _, config := range configs {
+		_, err := NewScoutServer().CreateGame(context.Background(), &pb.CreateGameRequest{NumPlayers: 2, Config: config})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Fatalf("%v: expected InvalidArgument, got %v", config, err)
+		}
+	}
+}
+
+func TestDefaultGameConfig(t *testing.T) {
+	s := NewScoutServer(WithDefaultGameConfig(GameConfig{TurnTime: time.Minute, TimeoutPolicy: "random"}))
+	defer s.Close()
+	ctx := context.Background()
+
+	plain, _ := s.CreateGame(ctx, &pb.CreateGameRequest{NumPlayers: 2})
+	if config := s.store.Get(plain.GameId).Config; config.TurnTime != time.Minute || config.TimeoutPolicy != "random" {
+		t.Fatalf("expected the default rules, got %+v", config)
+	}
+	own, _ := s.CreateGame(ctx, &pb.CreateGameRequest{NumPlayers: 2, Config: &pb.GameConfig{TotalTimeMs: proto.Int64(60000)}})
+	if config := s.store.Get(own.GameId).Config; config.TotalTime != time.Minute || config.TurnTime != time.Minute || config.TimeoutPolicy != "random" {
+		t.Fatalf("expected a game's own config to override only what it sets, got %+v", config)
+	}
+	untimed, _ := s.CreateGame(ctx, &pb.CreateGameRequest{NumPlayers: 2, Config: &pb.GameConfig{TurnTimeMs: proto.Int64(0)}})
+	if config := s.store.Get(untimed.GameId).Config; config.TurnTime != 0 || config.TimeoutPolicy != "random" {
+		t.Fatalf("expected a turn time of 0 to turn off the default, got %+v", config)
+	}
+
+	// a game can be more private than the server's default
+	s = NewScoutServer(WithDefaultGameConfig(GameConfig{SpectatorVisibility: VisibilityFullDelayed, SpectatorDelay: 5}))
+	public, _ := s.CreateGame(ctx, &pb.CreateGameRequest{NumPlayers: 2, Config: &pb.GameConfig{SpectatorVisibility: pb.Visibility_VisibilityPublic.Enum()}})
+	if config := s.store.Get(public.GameId).Config; config.SpectatorVisibility != VisibilityPublic {
+		t.Fatalf("expected the game's own visibility, got %+v", config)
+	}
+}
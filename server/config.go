@@ -0,0 +1,104 @@
+package server
+
+import (
+	"fmt"
+	"time"
+
+	pb "scout-go/proto"
+)
+
+// GameConfig holds the options a game is created with
+type GameConfig struct {
+	SpectatorVisibility Visibility    // the most spectators may see
+	SpectatorDelay      int           // for VisibilityFullDelayed, the fewest actions spectators are held back
+	TurnTime            time.Duration // per turn; 0 for no limit
+	TotalTime           time.Duration // per seat, for the whole game; 0 for no limit
+	TimeoutPolicy       string        // plays for a seat that runs out of time; DEFAULT_FALLBACK_POLICY if empty
+	DebugLog            bool          // log every state transition, whatever the server's log level; admins only
+}
+
+// WithDefaultGameConfig sets the options of games created without a config of their own
+func WithDefaultGameConfig(config GameConfig) Option {
+	return func(s *ScoutServer) {
+		s.defaultConfig = config
+	}
+}
+
+// gameConfig returns the options a request asks for, over the server's defaults: an option the
+// request leaves unset keeps the default
+func (s *ScoutServer) gameConfig(config *pb.GameConfig) GameConfig {
+	merged := s.defaultConfig
+	if config == nil {
+		return merged
+	}
+	asked := ToGameConfig(config)
+	if config.SpectatorVisibility != nil {
+		merged.SpectatorVisibility = asked.SpectatorVisibility
+	}
+	if config.SpectatorDelay != nil {
+		merged.SpectatorDelay = asked.SpectatorDelay
+	}
+	if config.TurnTimeMs != nil {
+		merged.TurnTime = asked.TurnTime
+	}
+	if config.TotalTimeMs != nil {
+		merged.TotalTime = asked.TotalTime
+	}
+	if config.TimeoutPolicy != nil {
+		merged.TimeoutPolicy = asked.TimeoutPolicy
+	}
+	if config.DebugLog != nil {
+		merged.DebugLog = asked.DebugLog
+	}
+	return merged
+}
+
+// Validate checks that the config's options make sense
+func (c GameConfig) Validate() error {
+	if c.SpectatorVisibility < VisibilityPublic || c.SpectatorVisibility > VisibilityFullAfterComplete {
+		return fmt.Errorf("unknown spectator visibility %d", c.SpectatorVisibility)
+	}
+	if c.SpectatorDelay < 0 {
+		return fmt.Errorf("spectator delay must not be negative")
+	}
+	// with no delay, full visibility would show the players each other's hands as they play
+	if c.SpectatorVisibility == VisibilityFullDelayed && c.SpectatorDelay < 1 {
+		return fmt.Errorf("spectator delay must be at least 1 for full delayed visibility")
+	}
+	if c.TurnTime < 0 || c.TotalTime < 0 {
+		return fmt.Errorf("time controls must not be negative")
+	}
+	if c.TimeoutPolicy != "" {
+		if _, err := NewPolicy(c.TimeoutPolicy); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Configure sets the game's options before anyone has played, and starts the active seat's
+// clock if the game has time controls and is under way
+func (g *Game) Configure(config GameConfig) error {
+	if err := config.Validate(); err != nil {
+		return err
+	}
+	if config.TimeoutPolicy == "" {
+		config.TimeoutPolicy = DEFAULT_FALLBACK_POLICY
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if len(g.History) > 0 {
+		return fmt.Errorf("game has already begun")
+	}
+	g.Config = config
+	g.clocks = make([]time.Duration, len(g.Players))
+	for i := range g.clocks {
+		g.clocks[i] = config.TotalTime
+	}
+	if g.phase() == PhasePlaying {
+		g.startTurn()
+	}
+	return nil
+}
@@ -2,7 +2,7 @@ package server
 
 import (
 	"fmt"
-	"math/rand"
+	"math/rand/v2"
 )
 
 type Deck []*Card
@@ -15,6 +15,26 @@ func NewDeck(cards []*Card) Deck {
 
 // NewGameDeck returns the deck used in the tabletop game
 func NewGameDeck(numPlayers int) (Deck, RulesViolation) {
+	cards, err := gameCards(numPlayers)
+	if err != nil {
+		return nil, err
+	}
+	return NewDeck(cards), nil
+}
+
+// newSeededGameDeck is NewGameDeck, shuffled with the given source of randomness
+func newSeededGameDeck(numPlayers int, r *rand.Rand) (Deck, RulesViolation) {
+	cards, err := gameCards(numPlayers)
+	if err != nil {
+		return nil, err
+	}
+	deck := Deck(cards)
+	deck.shuffle(r)
+	return deck, nil
+}
+
+// gameCards returns the unshuffled cards used for the given number of players
+func gameCards(numPlayers int) ([]*Card, RulesViolation) {
 	if numPlayers < 2 || numPlayers > 5 {
 		return nil, RulesViolation(fmt.Errorf("invalid number of players"))
 	}
@@ -39,7 +59,7 @@ func NewGameDeck(numPlayers int) (Deck, RulesViolation) {
 		}
 	}
 
-	return NewDeck(cards), nil
+	return cards, nil
 }
 
 func (d *Deck) Shuffle() {
@@ -49,7 +69,20 @@ func (d *Deck) Shuffle() {
 
 	// randomize orientation
 	for _, card := range *d {
-		if rand.Intn(2) == 0 {
+		if rand.IntN(2) == 0 {
+			card.ReverseValues()
+		}
+	}
+}
+
+func (d *Deck) shuffle(r *rand.Rand) {
+	r.Shuffle(len(*d), func(i, j int) {
+		(*d)[i], (*d)[j] = (*d)[j], (*d)[i]
+	})
+
+	// randomize orientation
+	for _, card := range *d {
+		if r.IntN(2) == 0 {
 			card.ReverseValues()
 		}
 	}
@@ -0,0 +1,125 @@
+package server
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"time"
+)
+
+const MAX_DETERMINIZATIONS = 1000 // per request
+
+// Determinize returns n complete game states that are consistent with everything the
+// observing player can know: their own hand, the active set, every hand size, and the
+// cards that were publicly scouted into other players' hands. the remaining cards in
+// the other players' hands are shuffled between them, with random orientation.
+// the same seed always produces the same samples.
+func (g *Game) Determinize(playerIndex, n int, seed int64) ([]*Game, RulesViolation) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	if playerIndex < 0 || playerIndex >= len(g.Players) {
+		return nil, RulesViolation(fmt.Errorf("invalid player index"))
+	}
+	if n < 1 || n > MAX_DETERMINIZATIONS {
+		return nil, RulesViolation(fmt.Errorf("number of samples must be between 1 and %d", MAX_DETERMINIZATIONS))
+	}
+
+	r := rand.New(rand.NewPCG(uint64(seed), 0))
+	samples := make([]*Game, n)
+	for i := range samples {
+		sample := g.clone()
+		// later deals are unknown to the observer too
+		sample.src.Seed(r.Uint64(), r.Uint64())
+
+		// collect the cards hidden from the observer
+		hidden := make([]*Card, 0)
+		for _, p := range sample.Players {
+			if p.Index == playerIndex {
+				continue
+			}
+			for _, card := range p.Hand {
+				if !card.Public {
+					hidden = append(hidden, card)
+				}
+			}
+		}
+
+		// deal them back into the same slots in a random order and orientation
+		r.Shuffle(len(hidden), func(i, j int) {
+			hidden[i], hidden[j] = hidden[j], hidden[i]
+		})
+		next := 0
+		for _, p := range sample.Players {
+			if p.Index == playerIndex {
+				continue
+			}
+			for j, card := range p.Hand {
+				if card.Public {
+					continue
+				}
+				p.Hand[j] = hidden[next]
+				if r.IntN(2) == 0 {
+					p.Hand[j].ReverseValues()
+				}
+				next++
+			}
+		}
+
+		samples[i] = sample
+	}
+
+	return samples, nil
+}
+
+// clone returns a deep copy of the game; the copy has its own cards, players and lock.
+func (g *Game) clone() *Game {
+	c := &Game{
+		Id:                g.Id,
+		NumPlayers:        g.NumPlayers,
+		ConsecutiveScouts: g.ConsecutiveScouts,
+		Round:             g.Round,
+		Complete:          g.Complete,
+		Lobby:             g.Lobby,
+		Seed:              g.Seed,
+		Config:            g.Config,
+		CreatedAt:         g.CreatedAt,
+		UpdatedAt:         g.UpdatedAt,
+		ActiveSet:         cloneCards(g.ActiveSet),
+	}
+
+	c.clocks = append([]time.Duration(nil), g.clocks...)
+	c.turnStarted = g.turnStarted
+	c.turns = g.turns
+
+	src := *g.src
+	c.src = &src
+	c.rng = rand.New(c.src)
+
+	c.Players = make([]*Player, len(g.Players))
+	for i, p := range g.Players {
+		player := *p
+		player.Hand = cloneCards(p.Hand)
+		c.Players[i] = &player
+	}
+
+	if g.ActivePlayer != nil {
+		c.ActivePlayer = c.Players[g.ActivePlayer.Index]
+	}
+	if g.ActiveSetPlayer != nil {
+		c.ActiveSetPlayer = c.Players[g.ActiveSetPlayer.Index]
+	}
+
+	return c
+}
+
+func cloneCards(cards []*Card) []*Card {
+	if cards == nil {
+		return nil
+	}
+	c := make([]*Card, len(cards))
+	for i, card := range cards {
+		cardCopy := *card
+		c[i] = &cardCopy
+	}
+	return c
+}
@@ -0,0 +1,75 @@
+package server
+
+import (
+	"fmt"
+	"sort"
+	"testing"
+)
+
+// handKeys returns the cards of the given hands, ignoring orientation, in sorted order
+func handKeys(hands ...[]*Card) []string {
+	keys := make([]string, 0)
+	for _, hand := range hands {
+		for _, c := range hand {
+			lo, hi := c.Value1, c.Value2
+			if lo > hi {
+				lo, hi = hi, lo
+			}
+			keys = append(keys, fmt.Sprintf("%d/%d", lo, hi))
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func TestDeterminize(t *testing.T) {
+	game, err := NewGame(3)
+	if err != nil {
+		t.Fatalf("NewGame returned err: %v", err)
+	}
+	// player 1 shows a single card, player 2 scouts it into the front of their hand
+	if err := game.PlayerAction(0, &ActionSpec{Type: ActionShow, ShowFirstIndex: 0, ShowLength: 1}); err != nil {
+		t.Fatalf("show returned err: %v", err)
+	}
+	if err := game.PlayerAction(1, &ActionSpec{Type: ActionScout, ScoutTakeIndex: 0, ScoutPutIndex: 0}); err != nil {
+		t.Fatalf("scout returned err: %v", err)
+	}
+	scouted := *game.Players[1].Hand[0]
+
+	observer := 2
+	samples, err := game.Determinize(observer, 20, 7)
+	if err != nil {
+		t.Fatalf("Determinize returned err: %v", err)
+	}
+	if len(samples) != 20 {
+		t.Fatalf("expected 20 samples, got %d", len(samples))
+	}
+
+	hiddenKeys := fmt.Sprint(handKeys(game.Players[0].Hand, game.Players[1].Hand))
+	for i, sample := range samples {
+		for j, p := range sample.Players {
+			if len(p.Hand) != len(game.Players[j].Hand) {
+				t.Fatalf("sample %d: player %d has %d cards, expected %d", i, j, len(p.Hand), len(game.Players[j].Hand))
+			}
+		}
+		for j, c := range sample.Players[observer].Hand {
+			if *c != *game.Players[observer].Hand[j] {
+				t.Fatalf("sample %d: observer's hand changed at %d", i, j)
+			}
+		}
+		if *sample.Players[1].Hand[0] != scouted {
+			t.Fatalf("sample %d: scouted card moved, got %v, expected %v", i, *sample.Players[1].Hand[0], scouted)
+		}
+		if got := fmt.Sprint(handKeys(sample.Players[0].Hand, sample.Players[1].Hand)); got != hiddenKeys {
+			t.Fatalf("sample %d: hidden cards changed, got %s, expected %s", i, got, hiddenKeys)
+		}
+	}
+
+	// same seed, same samples
+	again, _ := game.Determinize(observer, 20, 7)
+	for i := range samples {
+		if samples[i].ToJSON() != again[i].ToJSON() {
+			t.Fatalf("sample %d differs for the same seed", i)
+		}
+	}
+}
@@ -0,0 +1,155 @@
+package server
+
+const (
+	EventActionApplied EventType = iota
+	EventScoreChanged
+	EventRoundEnded
+	EventGameEnded
+	EventGameStarted
+)
+
+type EventType int
+
+// Event is something that happened in a game. every event carries a full copy of the
+// game as it stood afterwards; watchers only see the parts they are allowed to.
+type Event struct {
+	Type        EventType
+	Sequence    int64
+	PlayerIndex int // acting player for EventActionApplied, scoring player for EventScoreChanged
+	Action      ActionSpec
+	Scouted     *Card   // the card taken from the active set, in the orientation it was put in the hand
+	Shown       []*Card // the set shown
+	ScoreDelta  int
+	Score       int
+	Round       int  // the round that ended, for EventRoundEnded
+	Timeout     bool // the action was played for a seat that ran out of time
+	State       *Game
+}
+
+// Subscription receives a game's events. C is closed when the game ends, or when the
+// subscriber falls more than its buffer behind; Lagged reports which.
+type Subscription struct {
+	C      <-chan *Event
+	c      chan *Event
+	lagged bool
+}
+
+// Lagged reports whether the subscription was closed because its buffer filled up.
+// it is only meaningful once C has been closed.
+func (s *Subscription) Lagged() bool {
+	return s.lagged
+}
+
+// Subscribe returns a subscription to the game's events, buffering up to size of them.
+// subscribing to a complete or closed game returns a closed subscription.
+func (g *Game) Subscribe(size int) *Subscription {
+	c := make(chan *Event, size)
+	sub := &Subscription{C: c, c: c}
+
+	// hold off publishers until we're subscribed
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	g.subMu.Lock()
+	defer g.subMu.Unlock()
+
+	if g.Complete || g.closed {
+		close(c)
+		return sub
+	}
+	if g.subscribers == nil {
+		g.subscribers = make(map[*Subscription]struct{})
+	}
+	g.subscribers[sub] = struct{}{}
+	return sub
+}
+
+// Unsubscribe stops the subscription; it is safe to call more than once
+func (g *Game) Unsubscribe(sub *Subscription) {
+	g.subMu.Lock()
+	defer g.subMu.Unlock()
+	if _, ok := g.subscribers[sub]; ok {
+		delete(g.subscribers, sub)
+		close(sub.c)
+	}
+}
+
+// publish hands the events to every subscriber without blocking. a subscriber whose
+// buffer is full is dropped rather than letting events pile up.
+func (g *Game) publish(events ...*Event) {
+	g.subMu.Lock()
+	defer g.subMu.Unlock()
+
+	for sub := range g.subscribers {
+		for _, e := range events {
+			select {
+			case sub.c <- e:
+			default:
+				sub.lagged = true
+			}
+			if sub.lagged {
+				break
+			}
+		}
+		if sub.lagged {
+			delete(g.subscribers, sub)
+			close(sub.c)
+		}
+	}
+
+	if g.Complete {
+		for sub := range g.subscribers {
+			delete(g.subscribers, sub)
+			close(sub.c)
+		}
+	}
+}
+
+// publishAction publishes the events caused by an action. must be called with g.mu held.
+func (g *Game) publishAction(playerIndex int, action *ActionSpec, scouted *Card, shown []*Card, before []int, roundEnded, timeout bool) {
+	// with nobody watching, skip copying the game on every move. delayed watchers hold their
+	// own queues, so there is nothing to keep for them either; new ones start from here.
+	g.subMu.Lock()
+	watched := len(g.subscribers) > 0
+	g.subMu.Unlock()
+	if !watched {
+		return
+	}
+
+	state := g.clone()
+	events := make([]*Event, 0)
+	add := func(e *Event) {
+		g.sequence++
+		e.Sequence = g.sequence
+		e.State = state
+		events = append(events, e)
+	}
+
+	add(&Event{Type: EventActionApplied, PlayerIndex: playerIndex, Action: *action, Scouted: scouted, Shown: shown, Timeout: timeout})
+	for i, score := range g.scores() {
+		if score != before[i] {
+			add(&Event{Type: EventScoreChanged, PlayerIndex: i, ScoreDelta: score - before[i], Score: score})
+		}
+	}
+	if roundEnded {
+		add(&Event{Type: EventRoundEnded, Round: g.Round - 1})
+	}
+	if g.Complete {
+		add(&Event{Type: EventGameEnded, Round: g.Round - 1})
+	}
+
+	g.publish(events...)
+}
+
+// publishStart publishes the start of a lobby game. must be called with g.mu held.
+func (g *Game) publishStart() {
+	g.sequence++
+	g.publish(&Event{Type: EventGameStarted, Sequence: g.sequence, State: g.clone()})
+}
+
+func (g *Game) scores() []int {
+	scores := make([]int, len(g.Players))
+	for i, p := range g.Players {
+		scores[i] = p.Score
+	}
+	return scores
+}
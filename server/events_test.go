@@ -0,0 +1,161 @@
+package server
+
+import (
+	"context"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+
+	pb "scout-go/proto"
+)
+
+// dialServer serves s over an in-memory connection and returns a client for it
+func dialServer(t *testing.T, s *ScoutServer) pb.ScoutServiceClient {
+	lis := bufconn.Listen(1 << 20)
+	srv := grpc.NewServer()
+	pb.RegisterScoutServiceServer(srv, s)
+	go srv.Serve(lis)
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufconn",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatalf("failed to dial: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return pb.NewScoutServiceClient(conn)
+}
+
+// waitForSubscribers waits for the game to have n watchers
+func waitForSubscribers(t *testing.T, game *Game, n int) {
+	deadline := time.Now().Add(10 * time.Second)
+	for time.Now().Before(deadline) {
+		game.subMu.Lock()
+		count := len(game.subscribers)
+		game.subMu.Unlock()
+		if count == n {
+			return
+		}
+		time.Sleep(time.Millisecond)
+	}
+	t.Fatalf("expected %d watchers", n)
+}
+
+func TestWatchGame(t *testing.T) {
+	s := NewScoutServer()
+	client := dialServer(t, s)
+	ctx := context.Background()
+
+	created, err := client.CreateGame(ctx, &pb.CreateGameRequest{NumPlayers: 2})
+	if err != nil {
+		t.Fatalf("CreateGame returned err: %v", err)
+	}
+	seat, err := client.WatchGame(dialAsSeat(created.SeatTokens[1]), &pb.WatchGameRequest{GameId: created.GameId, PlayerIndex: 1})
+	if err != nil {
+		t.Fatalf("WatchGame returned err: %v", err)
+	}
+	spectator, err := client.WatchGame(ctx, &pb.WatchGameRequest{GameId: created.GameId, Spectator: true})
+	if err != nil {
+		t.Fatalf("WatchGame returned err: %v", err)
+	}
+	// make sure both streams are subscribed before acting
+	game := s.store.Get(created.GameId)
+	waitForSubscribers(t, game, 2)
+
+	// player 1 shows, player 2 scouts, which ends the round in a 2 player game
+	show := &pb.Action{ActionType: pb.Action_ActionShow, ShowFirstIndex: 0, ShowLength: 1}
+	if resp, _ := client.PlayerAction(dialAsSeat(created.SeatTokens[0]), &pb.PlayerActionRequest{GameId: created.GameId, PlayerIndex: 0, Action: show}); resp.Err {
+		t.Fatalf("show failed: %s", resp.ErrMsg)
+	}
+	scout := &pb.Action{ActionType: pb.Action_ActionScout}
+	if resp, _ := client.PlayerAction(dialAsSeat(created.SeatTokens[1]), &pb.PlayerActionRequest{GameId: created.GameId, PlayerIndex: 1, Action: scout}); resp.Err {
+		t.Fatalf("scout failed: %s", resp.ErrMsg)
+	}
+
+	expected := []pb.GameEvent_EventType{
+		pb.GameEvent_EventActionApplied,
+		pb.GameEvent_EventActionApplied,
+		pb.GameEvent_EventScoreChanged,
+	}
+	for _, stream := range []pb.ScoutService_WatchGameClient{seat, spectator} {
+		for i, eventType := range expected {
+			e, err := stream.Recv()
+			if err != nil {
+				t.Fatalf("event %d: Recv returned err: %v", i, err)
+			}
+			if e.EventType != eventType {
+				t.Fatalf("event %d: expected %v, got %v", i, eventType, e.EventType)
+			}
+			if e.Sequence != int64(i+1) {
+				t.Fatalf("event %d: expected sequence %d, got %d", i, i+1, e.Sequence)
+			}
+			if stream == seat && (e.Player == nil || e.Player.Index != 1) {
+				t.Fatalf("event %d: expected the seat's own state, got %v", i, e.Player)
+			}
+			if stream == spectator && e.Player != nil {
+				t.Fatalf("event %d: spectator received a hand", i)
+			}
+		}
+	}
+}
+
+func TestSlowSubscriberIsDropped(t *testing.T) {
+	game, err := NewGame(3)
+	if err != nil {
+		t.Fatalf("NewGame returned err: %v", err)
+	}
+	sub := game.Subscribe(1)
+
+	// the show fills the buffer, the scout overflows it
+	game.PlayerAction(0, &ActionSpec{Type: ActionShow, ShowFirstIndex: 0, ShowLength: 1})
+	game.PlayerAction(1, &ActionSpec{Type: ActionScout, ScoutTakeIndex: 0, ScoutPutIndex: 0})
+
+	n := 0
+	for range sub.C {
+		n++
+	}
+	if n != 1 {
+		t.Fatalf("expected 1 buffered event, got %d", n)
+	}
+	if !sub.Lagged() {
+		t.Fatalf("expected the subscription to be marked as lagged")
+	}
+}
+
+func TestUnwatchedGamePublishesNothing(t *testing.T) {
+	game, err := NewGame(3)
+	if err != nil {
+		t.Fatalf("NewGame returned err: %v", err)
+	}
+	action := &ActionSpec{Type: ActionShow, ShowFirstIndex: 0, ShowLength: 1}
+	before := game.scores()
+
+	game.mu.Lock()
+	defer game.mu.Unlock()
+	if allocs := testing.AllocsPerRun(10, func() { game.publishAction(0, action, nil, nil, before, false, false) }); allocs != 0 {
+		t.Fatalf("expected no copies of an unwatched game, got %v allocations", allocs)
+	}
+}
+
+func TestWatchCompleteGame(t *testing.T) {
+	s := NewScoutServer()
+	client := dialServer(t, s)
+	ctx := context.Background()
+
+	created, _ := client.CreateGame(ctx, &pb.CreateGameRequest{NumPlayers: 2})
+	s.store.Get(created.GameId).Complete = true
+
+	stream, err := client.WatchGame(ctx, &pb.WatchGameRequest{GameId: created.GameId, Spectator: true})
+	if err != nil {
+		t.Fatalf("WatchGame returned err: %v", err)
+	}
+	if _, err := stream.Recv(); err != io.EOF {
+		t.Fatalf("expected the stream to end, got %v", err)
+	}
+}
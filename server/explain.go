@@ -0,0 +1,160 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	pb "scout-go/proto"
+)
+
+// ActionProblem is one reason an action can't be taken, with the cards it concerns
+type ActionProblem struct {
+	Code    ViolationCode
+	Message string
+	Cards   []*Card
+}
+
+// ExplainAction runs the same checks as PlayerAction and returns every one the action fails,
+// rather than stopping at the first. an action with no problems would be accepted.
+func (g *Game) ExplainAction(playerIndex int, action *ActionSpec) []ActionProblem {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	var problems []ActionProblem
+	add := func(code ViolationCode, cards []*Card, format string, args ...any) {
+		problems = append(problems, ActionProblem{Code: code, Message: fmt.Sprintf(format, args...), Cards: cloneCards(cards)})
+	}
+
+	if playerIndex < 0 || playerIndex >= len(g.Players) {
+		add(ViolationIndexOutOfRange, nil, "player %d is not in the game", playerIndex)
+		return problems
+	}
+	switch {
+	case g.Complete:
+		add(ViolationGameComplete, nil, "game is complete")
+	case g.Lobby:
+		add(ViolationGameNotStarted, nil, "game has not started")
+	case playerIndex != g.ActivePlayer.Index:
+		add(ViolationNotYourTurn, nil, "it is player %d's turn, not player %d's", g.ActivePlayer.Index, playerIndex)
+	}
+
+	p := g.Players[playerIndex]
+	switch action.Type {
+	case ActionScout, ActionScoutReverse:
+		g.explainScout(p, action.ScoutTakeIndex, action.ScoutPutIndex, add)
+	case ActionShow:
+		g.explainShow(p.Hand, action.ShowFirstIndex, action.ShowLength, "", add)
+	case ActionScoutAndShow, ActionScoutAndShowReverse:
+		if !p.CanScoutAndShow {
+			add(ViolationScoutAndShowUsed, nil, "scout and show has already been used this round")
+		}
+		if !g.explainScout(p, action.ScoutTakeIndex, action.ScoutPutIndex, add) {
+			break
+		}
+		// show from the hand as it would be after the scout
+		hand := append([]*Card(nil), p.Hand...)
+		card := *g.ActiveSet[action.ScoutTakeIndex]
+		if action.Type == ActionScoutAndShowReverse {
+			card.ReverseValues()
+		}
+		hand = append(hand[:action.ScoutPutIndex], append([]*Card{&card}, hand[action.ScoutPutIndex:]...)...)
+		g.explainShow(hand, action.ShowFirstIndex, action.ShowLength, "after scouting, ", add)
+	case ActionReverseHand:
+		if !p.CanReverseHand {
+			add(ViolationReverseHandNotAllowed, nil, "hand can only be reversed before the first action")
+		}
+	default:
+		add(ViolationUnknownAction, nil, "unknown action type %d", action.Type)
+	}
+	return problems
+}
+
+// explainScout adds the problems with scouting, and returns whether the scout itself is
+// possible, so the show that follows can be checked
+func (g *Game) explainScout(p *Player, takeIndex, putIndex int, add func(ViolationCode, []*Card, string, ...any)) bool {
+	ok := true
+	switch {
+	case len(g.ActiveSet) == 0:
+		add(ViolationNothingToScout, nil, "there is no active set to scout from")
+		ok = false
+	case takeIndex < 0 || takeIndex >= len(g.ActiveSet):
+		add(ViolationIndexOutOfRange, g.ActiveSet, "take index %d is outside the length %d active set", takeIndex, len(g.ActiveSet))
+		ok = false
+	case takeIndex != 0 && takeIndex != len(g.ActiveSet)-1:
+		add(ViolationScoutNotFromEnd, g.ActiveSet[takeIndex:takeIndex+1], "card %d is not at either end of the length %d active set", takeIndex, len(g.ActiveSet))
+	}
+	if putIndex < 0 || putIndex > len(p.Hand) {
+		add(ViolationIndexOutOfRange, nil, "put index %d is outside a hand of %d cards", putIndex, len(p.Hand))
+		ok = false
+	}
+	return ok
+}
+
+// explainShow adds the problems with showing hand[firstIndex:firstIndex+length], as validateSet
+// and compareSets find them
+func (g *Game) explainShow(hand []*Card, firstIndex, length int, prefix string, add func(ViolationCode, []*Card, string, ...any)) {
+	if firstIndex < 0 || length < 0 || firstIndex+length > len(hand) {
+		add(ViolationIndexOutOfRange, nil, "%sshow %d:%d is outside a hand of %d cards", prefix, firstIndex, firstIndex+length, len(hand))
+		return
+	}
+	set := hand[firstIndex : firstIndex+length]
+	if err := validateSet(set); err != nil {
+		code := ViolationCodeOf(err)
+		if code == ViolationSetEmpty {
+			add(code, nil, "%sset cannot be empty", prefix)
+			return
+		}
+		add(code, set, "%sset %s is neither consecutive nor matching", prefix, setValues(set))
+	}
+
+	active := g.ActiveSet
+	switch compareSets(set, active) {
+	case setShorter:
+		add(ViolationSetTooWeak, set, "%slength %d set cannot beat length %d active set %s", prefix, len(set), len(active), setValues(active))
+	case setConsecutive:
+		add(ViolationSetTooWeak, set, "%sconsecutive set %s cannot beat matching active set %s", prefix, setValues(set), setValues(active))
+	case setLowestValueLower:
+		add(ViolationSetTooWeak, set, "%sset %s with lowest value %d does not beat active set %s with lowest value %d",
+			prefix, setValues(set), minValue(set), setValues(active), minValue(active))
+	}
+}
+
+// setValues writes the values a set is played with, e.g. 4,6
+func setValues(set []*Card) string {
+	values := make([]string, len(set))
+	for i, card := range set {
+		values[i] = strconv.Itoa(card.Value1)
+	}
+	return strings.Join(values, ",")
+}
+
+func (s *ScoutServer) ExplainAction(ctx context.Context, req *pb.ExplainActionRequest) (*pb.ExplainActionResponse, error) {
+	game := s.store.Get(req.GameId)
+
+	if game == nil {
+		return nil, errInvalidGameId
+	}
+	if int(req.PlayerIndex) < 0 || int(req.PlayerIndex) >= len(game.Players) {
+		return nil, errInvalidPlayerIndex
+	}
+	if req.Action == nil {
+		return nil, fmt.Errorf("action is required")
+	}
+	// the explanation shows cards from the seat's hand
+	if err := s.authorizeSeat(ctx, game, int(req.PlayerIndex)); err != nil {
+		return nil, err
+	}
+
+	resp := &pb.ExplainActionResponse{}
+	for _, problem := range game.ExplainAction(int(req.PlayerIndex), ToActionSpec(req.Action)) {
+		proto := &pb.ActionProblem{Code: pb.ViolationCode(problem.Code), Message: problem.Message}
+		for _, card := range problem.Cards {
+			proto.Cards = append(proto.Cards, card.ToProto())
+		}
+		resp.Problems = append(resp.Problems, proto)
+	}
+	resp.Valid = len(resp.Problems) == 0
+	return resp, nil
+}
@@ -0,0 +1,113 @@
+package server
+
+import (
+	"context"
+	"math/rand/v2"
+	"slices"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "scout-go/proto"
+)
+
+func TestExplainActionAgreesWithIsActionValid(t *testing.T) {
+	all := AllActions()
+	for _, numPlayers := range []int{2, 3, 4, 5} {
+		for seed := int64(1); seed <= 3; seed++ {
+			game, _ := NewSeededGame(numPlayers, seed)
+			r := rand.New(rand.NewPCG(uint64(seed), 8))
+			for step := 0; step < 40 && game.Phase() == PhasePlaying; step++ {
+				seat, _ := game.turn()
+				// the whole action space is large, so check every legal action and a sample of the rest
+				actions := game.LegalActions(seat)
+				for range 2000 {
+					actions = append(actions, all[r.IntN(len(all))])
+				}
+				for _, action := range actions {
+					problems := game.ExplainAction(seat, &action)
+					game.mu.RLock()
+					err := game.checkAction(seat, &action)
+					game.mu.RUnlock()
+					if (err == nil) != (len(problems) == 0) {
+						t.Fatalf("%d players seed %d step %d action %s: checkAction says %v, ExplainAction says %v",
+							numPlayers, seed, step, action.Format(), err, problems)
+					}
+					// the rule the action is rejected for is among those explained
+					if err != nil && !slices.ContainsFunc(problems, func(p ActionProblem) bool { return p.Code == ViolationCodeOf(err) }) {
+						t.Fatalf("%d players seed %d step %d action %s: rejected with %v, but ExplainAction says %v",
+							numPlayers, seed, step, action.Format(), err, problems)
+					}
+				}
+				action := RandomPolicy{}.ChooseAction(game, seat, game.LegalActions(seat), r)
+				game.PlayerAction(seat, &action)
+			}
+		}
+	}
+}
+
+func TestExplainAction(t *testing.T) {
+	tests := []struct {
+		name     string
+		position string
+		player   int
+		move     string
+		expected []string
+	}{
+		{"valid", "set=- seat=5/6:0:RS seat=7/8:0:RS", 0, "H0:1", nil},
+		{"broken set", "set=- seat=4/1,6/2:0:RS seat=7/8:0:RS", 0, "H0:2", []string{"set 4,6 is neither consecutive nor matching"}},
+		{"short set", "set=2/10,3/10,4/10 owner=1 seat=5/1,6/2:0:RS seat=7/8:0:RS", 0, "H0:2", []string{"length 2 set cannot beat length 3 active set 2,3,4"}},
+		{"consecutive against matching", "set=3/10,3/4 owner=1 seat=5/1,6/2:0:RS seat=7/8:0:RS", 0, "H0:2", []string{"consecutive set 5,6 cannot beat matching active set 3,3"}},
+		{"low set", "set=6/10 owner=1 seat=5/1:0:RS seat=7/8:0:RS", 0, "H0:1", []string{"set 5 with lowest value 5 does not beat active set 6 with lowest value 6"}},
+		{"every problem", "set=2/10,3/10,4/10 owner=0 active=0 seat=5/6:0:RS seat=7/8,9/1:0:R", 1, "S1>5+H0:1", []string{
+			"it is player 0's turn, not player 1's",
+			"scout and show has already been used this round",
+			"card 1 is not at either end of the length 3 active set",
+			"put index 5 is outside a hand of 2 cards",
+		}},
+		{"scout then show", "set=2/10,3/10 owner=1 seat=5/6:0:RS seat=7/8:0:RS", 0, "S0>0+H0:1", []string{"after scouting, length 1 set cannot beat length 2 active set 2,3"}},
+	}
+
+	for _, test := range tests {
+		state, err := ParsePosition(test.position)
+		if err != nil {
+			t.Fatalf("%s: ParsePosition returned err: %v", test.name, err)
+		}
+		game, err := NewGameFromState(state, 1)
+		if err != nil {
+			t.Fatalf("%s: NewGameFromState returned err: %v", test.name, err)
+		}
+		action, err := ParseAction(test.move)
+		if err != nil {
+			t.Fatalf("%s: ParseAction returned err: %v", test.name, err)
+		}
+		problems := game.ExplainAction(test.player, &action)
+		messages := make([]string, len(problems))
+		for i, problem := range problems {
+			messages[i] = problem.Message
+		}
+		if strings.Join(messages, "\n") != strings.Join(test.expected, "\n") {
+			t.Fatalf("%s: expected %q, got %q", test.name, test.expected, messages)
+		}
+	}
+}
+
+func TestExplainActionRPC(t *testing.T) {
+	s := NewScoutServer()
+	ctx := context.Background()
+	created, _ := s.CreateGame(ctx, &pb.CreateGameRequest{NumPlayers: 2})
+	req := &pb.ExplainActionRequest{GameId: created.GameId, PlayerIndex: 0, Action: &pb.Action{ActionType: pb.Action_ActionScout}}
+
+	if _, err := s.ExplainAction(ctx, req); status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("expected spectators to be denied, got %v", err)
+	}
+	resp, err := s.ExplainAction(asSeat(created.SeatTokens[0]), req)
+	if err != nil {
+		t.Fatalf("ExplainAction returned err: %v", err)
+	}
+	if resp.Valid || len(resp.Problems) != 1 || resp.Problems[0].Code != pb.ViolationCode_ViolationNothingToScout {
+		t.Fatalf("expected nothing to scout, got %v", resp)
+	}
+}
@@ -0,0 +1,303 @@
+package server
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	SNAPSHOT_SUFFIX = ".snapshot.json"
+	ACTIONS_SUFFIX  = ".actions.jsonl"
+)
+
+// FileStore keeps games in memory, and writes each one to a directory as it changes: a
+// snapshot of everything needed to deal it again, and a log of every move. games are
+// reloaded by dealing them again from their seed, or setting up their starting position,
+// and replaying their moves.
+type FileStore struct {
+	dir   string
+	mu    sync.RWMutex
+	games map[string]*fileGame
+}
+
+type fileGame struct {
+	game    *Game
+	mu      sync.Mutex // serializes writes
+	written int        // moves already in the action log
+}
+
+// fileSnapshot is a game, less its moves
+type fileSnapshot struct {
+	Id         string
+	NumPlayers int
+	Seed       int64
+	Start      *GameState `json:",omitempty"`
+	Config     GameConfig
+	Lobby      bool
+	Seats      []fileSeat
+	Clocks     []time.Duration
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+type fileSeat struct {
+	Name      string
+	Seated    bool
+	Ready     bool
+	TokenHash string // the seat token itself is never written; see hashToken
+	Owner     string `json:",omitempty"`
+}
+
+// NewFileStore returns a store that keeps its games in dir, loading any already there
+func NewFileStore(dir string) (*FileStore, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, err
+	}
+	f := &FileStore{dir: dir, games: make(map[string]*fileGame)}
+
+	paths, err := filepath.Glob(filepath.Join(dir, "*"+SNAPSHOT_SUFFIX))
+	if err != nil {
+		return nil, err
+	}
+	for _, path := range paths {
+		id := strings.TrimSuffix(filepath.Base(path), SNAPSHOT_SUFFIX)
+		game, err := f.load(id)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load game %s: %v", id, err)
+		}
+		f.games[id] = &fileGame{game: game, written: len(game.History)}
+	}
+	return f, nil
+}
+
+func (f *FileStore) Get(id string) *Game {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	if fg := f.games[id]; fg != nil {
+		return fg.game
+	}
+	return nil
+}
+
+func (f *FileStore) Add(game *Game) error {
+	f.mu.Lock()
+	if _, ok := f.games[game.Id]; ok {
+		f.mu.Unlock()
+		return fmt.Errorf("game %s already exists", game.Id)
+	}
+	fg := &fileGame{game: game}
+	f.games[game.Id] = fg
+	f.mu.Unlock()
+
+	return f.save(fg)
+}
+
+func (f *FileStore) Save(game *Game) error {
+	f.mu.RLock()
+	fg := f.games[game.Id]
+	f.mu.RUnlock()
+
+	// the game may have been deleted since it changed
+	if fg == nil {
+		return nil
+	}
+	return f.save(fg)
+}
+
+func (f *FileStore) Delete(id string) error {
+	f.mu.Lock()
+	fg := f.games[id]
+	delete(f.games, id)
+	f.mu.Unlock()
+
+	if fg == nil {
+		return nil
+	}
+	fg.mu.Lock()
+	defer fg.mu.Unlock()
+	for _, path := range []string{f.path(id, SNAPSHOT_SUFFIX), f.path(id, ACTIONS_SUFFIX)} {
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+func (f *FileStore) List() []*Game {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	games := make([]*Game, 0, len(f.games))
+	for _, fg := range f.games {
+		games = append(games, fg.game)
+	}
+	return games
+}
+
+func (f *FileStore) path(id, suffix string) string {
+	return filepath.Join(f.dir, id+suffix)
+}
+
+// save appends the game's new moves to its action log, then rewrites its snapshot
+func (f *FileStore) save(fg *fileGame) error {
+	fg.mu.Lock()
+	defer fg.mu.Unlock()
+
+	g := fg.game
+	g.mu.RLock()
+	snapshot := fileSnapshot{
+		Id:         g.Id,
+		NumPlayers: g.NumPlayers,
+		Seed:       g.Seed,
+		Start:      g.Start,
+		Config:     g.Config,
+		Lobby:      g.Lobby,
+		Clocks:     append([]time.Duration(nil), g.clocks...),
+		CreatedAt:  g.CreatedAt,
+		UpdatedAt:  g.UpdatedAt,
+	}
+	for _, p := range g.Players {
+		snapshot.Seats = append(snapshot.Seats, fileSeat{Name: p.Name, Seated: p.Seated, Ready: p.Ready, TokenHash: g.tokenHashes[p.Index], Owner: g.owners[p.Index]})
+	}
+	moves := append([]Move(nil), g.History[fg.written:]...)
+	g.mu.RUnlock()
+
+	var lines bytes.Buffer
+	for _, move := range moves {
+		line, err := json.Marshal(move)
+		if err != nil {
+			return err
+		}
+		lines.Write(line)
+		lines.WriteByte('\n')
+	}
+	actions, err := os.OpenFile(f.path(g.Id, ACTIONS_SUFFIX), os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	if _, err := actions.Write(lines.Bytes()); err != nil {
+		actions.Close()
+		return err
+	}
+	if err := actions.Close(); err != nil {
+		return err
+	}
+	fg.written += len(moves)
+
+	// write the snapshot next to the old one and swap it in, so it is never half written
+	data, err := json.MarshalIndent(snapshot, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp := f.path(g.Id, SNAPSHOT_SUFFIX) + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, f.path(g.Id, SNAPSHOT_SUFFIX))
+}
+
+// load deals the game again from its snapshot and replays its action log
+func (f *FileStore) load(id string) (*Game, error) {
+	data, err := os.ReadFile(f.path(id, SNAPSHOT_SUFFIX))
+	if err != nil {
+		return nil, err
+	}
+	var snapshot fileSnapshot
+	if err := json.Unmarshal(data, &snapshot); err != nil {
+		return nil, err
+	}
+	if len(snapshot.Seats) != snapshot.NumPlayers {
+		return nil, fmt.Errorf("snapshot has %d seats for %d players", len(snapshot.Seats), snapshot.NumPlayers)
+	}
+
+	var g *Game
+	if snapshot.Start != nil {
+		g, err = NewGameFromState(*snapshot.Start, snapshot.Seed)
+	} else {
+		g, err = NewSeededGame(snapshot.NumPlayers, snapshot.Seed)
+	}
+	if err != nil {
+		return nil, err
+	}
+	g.Id = snapshot.Id
+	g.Lobby = snapshot.Lobby
+	for i, seat := range snapshot.Seats {
+		g.Players[i].Name = seat.Name
+		g.Players[i].Seated = seat.Seated
+		g.Players[i].Ready = seat.Ready
+		g.tokenHashes[i] = seat.TokenHash
+		g.owners[i] = seat.Owner
+	}
+	if err := g.Configure(snapshot.Config); err != nil {
+		return nil, err
+	}
+
+	moves, err := readMoves(f.path(id, ACTIONS_SUFFIX))
+	if err != nil {
+		return nil, err
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	for i, move := range moves {
+		if err := g.playerAction(move.PlayerIndex, &move.Action, move.Timeout); err != nil {
+			return nil, fmt.Errorf("move %d: %v", i, err)
+		}
+	}
+	g.CreatedAt = snapshot.CreatedAt
+	g.UpdatedAt = snapshot.UpdatedAt
+	if len(snapshot.Clocks) == len(g.clocks) {
+		copy(g.clocks, snapshot.Clocks)
+	}
+	// the seat to move gets its turn over again
+	if g.phase() == PhasePlaying {
+		g.startTurn()
+	}
+	return g, nil
+}
+
+// readMoves reads an action log. a last line cut short by a crash is cut off, so that
+// the next move starts a line of its own.
+func readMoves(path string) ([]Move, error) {
+	file, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	moves := make([]Move, 0)
+	complete := int64(0)
+	reader := bufio.NewReader(file)
+	for {
+		line, err := reader.ReadBytes('\n')
+		if err == io.EOF {
+			if len(line) > 0 {
+				return moves, os.Truncate(path, complete)
+			}
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		var move Move
+		if err := json.Unmarshal(line, &move); err != nil {
+			return nil, err
+		}
+		moves = append(moves, move)
+		complete += int64(len(line))
+	}
+	return moves, nil
+}
@@ -0,0 +1,177 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"math/rand/v2"
+	"os"
+	"reflect"
+	"testing"
+
+	pb "scout-go/proto"
+)
+
+func TestFileStoreReloadsGames(t *testing.T) {
+	dir := t.TempDir()
+	store, err := NewFileStore(dir)
+	if err != nil {
+		t.Fatalf("NewFileStore returned err: %v", err)
+	}
+	s := NewScoutServer(WithStore(store))
+	ctx := context.Background()
+
+	created, err := s.CreateGame(ctx, &pb.CreateGameRequest{NumPlayers: 3})
+	if err != nil {
+		t.Fatalf("CreateGame returned err: %v", err)
+	}
+	open, _ := s.CreateGame(ctx, &pb.CreateGameRequest{NumPlayers: 2, Open: true})
+	joined, _ := s.JoinGame(ctx, &pb.JoinGameRequest{GameId: open.GameId, Name: "alice"})
+
+	// play some of the game
+	game := store.Get(created.GameId)
+	r := rand.New(rand.NewPCG(1, 2))
+	for i := 0; i < 20 && game.Phase() == PhasePlaying; i++ {
+		seat, _ := game.turn()
+		action := RandomPolicy{}.ChooseAction(game, seat, game.LegalActions(seat), r)
+		resp, err := s.PlayerAction(asSeat(created.SeatTokens[seat]), &pb.PlayerActionRequest{
+			GameId: created.GameId, PlayerIndex: int32(seat), Action: action.ToProto(),
+		})
+		if err != nil || resp.Err {
+			t.Fatalf("move %d failed: %v %s", i, err, resp.GetErrMsg())
+		}
+	}
+
+	reloaded, err := NewFileStore(dir)
+	if err != nil {
+		t.Fatalf("NewFileStore returned err: %v", err)
+	}
+	if n := len(reloaded.List()); n != 2 {
+		t.Fatalf("expected 2 games, got %d", n)
+	}
+
+	again := reloaded.Get(created.GameId)
+	if !reflect.DeepEqual(again.History, game.History) {
+		t.Fatalf("expected the same history")
+	}
+	for i := range game.Players {
+		if !reflect.DeepEqual(again.Players[i].Hand, game.Players[i].Hand) || again.Players[i].Score != game.Players[i].Score {
+			t.Fatalf("seat %d: expected the same hand and score", i)
+		}
+		if !again.checkSeatToken(i, created.SeatTokens[i]) {
+			t.Fatalf("seat %d: expected its token to still hold the seat", i)
+		}
+	}
+	if again.ActivePlayer.Index != game.ActivePlayer.Index || !reflect.DeepEqual(again.ActiveSet, game.ActiveSet) {
+		t.Fatalf("expected the same table")
+	}
+
+	lobby := reloaded.Get(open.GameId)
+	if lobby.Phase() != PhaseLobby || lobby.Players[0].Name != "alice" || !lobby.checkSeatToken(0, joined.SeatToken) {
+		t.Fatalf("expected the lobby to be restored")
+	}
+
+	// only the tokens' hashes are written
+	data, err := os.ReadFile(reloaded.path(open.GameId, SNAPSHOT_SUFFIX))
+	if err != nil || bytes.Contains(data, []byte(joined.SeatToken)) {
+		t.Fatalf("expected the snapshot to leave out the seat token, got %v:\n%s", err, data)
+	}
+
+	if err := reloaded.Delete(open.GameId); err != nil {
+		t.Fatalf("Delete returned err: %v", err)
+	}
+	if _, err := os.Stat(reloaded.path(open.GameId, SNAPSHOT_SUFFIX)); !os.IsNotExist(err) {
+		t.Fatalf("expected the snapshot to be removed, got %v", err)
+	}
+}
+
+func TestFileStoreIgnoresTornMove(t *testing.T) {
+	dir := t.TempDir()
+	store, _ := NewFileStore(dir)
+	s := NewScoutServer(WithStore(store))
+
+	created, _ := s.CreateGame(context.Background(), &pb.CreateGameRequest{NumPlayers: 2})
+	show := &pb.Action{ActionType: pb.Action_ActionShow, ShowLength: 1}
+	s.PlayerAction(asSeat(created.SeatTokens[0]), &pb.PlayerActionRequest{GameId: created.GameId, Action: show})
+
+	// a crash halfway through writing the next move
+	log, _ := os.OpenFile(store.path(created.GameId, ACTIONS_SUFFIX), os.O_APPEND|os.O_WRONLY, 0)
+	log.WriteString(`{"PlayerIndex":1,"Act`)
+	log.Close()
+
+	reloaded, err := NewFileStore(dir)
+	if err != nil {
+		t.Fatalf("NewFileStore returned err: %v", err)
+	}
+	game := reloaded.Get(created.GameId)
+	if len(game.History) != 1 {
+		t.Fatalf("expected 1 move, got %d", len(game.History))
+	}
+
+	// and the next move is readable again
+	game.PlayerAction(1, &ActionSpec{Type: ActionScout})
+	reloaded.Save(game)
+	if again, err := NewFileStore(dir); err != nil || len(again.Get(created.GameId).History) != 2 {
+		t.Fatalf("expected 2 moves after reloading, got %v", err)
+	}
+}
+
+func TestFileStoreReloadsGameFromState(t *testing.T) {
+	dir := t.TempDir()
+	store, _ := NewFileStore(dir)
+	game, err := NewGameFromState(GameState{
+		Seats: []SeatState{
+			{Hand: cards([2]int{3, 4}, [2]int{8, 9}), CanReverseHand: true, CanScoutAndShow: true},
+			{Hand: cards([2]int{5, 6}), CanReverseHand: true, CanScoutAndShow: true},
+		},
+	}, 1)
+	if err != nil {
+		t.Fatalf("NewGameFromState returned err: %v", err)
+	}
+	store.Add(game)
+	game.PlayerAction(0, &ActionSpec{Type: ActionShow, ShowFirstIndex: 0, ShowLength: 1})
+	store.Save(game)
+
+	reloaded, err := NewFileStore(dir)
+	if err != nil {
+		t.Fatalf("NewFileStore returned err: %v", err)
+	}
+	again := reloaded.Get(game.Id)
+	if !reflect.DeepEqual(again.Players[0].Hand, game.Players[0].Hand) || !reflect.DeepEqual(again.ActiveSet, game.ActiveSet) {
+		t.Fatalf("expected the position to be restored")
+	}
+}
+
+func TestFileStoreReloadsImportedGameFromState(t *testing.T) {
+	dir := t.TempDir()
+	store, _ := NewFileStore(dir)
+	game, err := NewGameFromState(GameState{
+		Seats: []SeatState{
+			{Hand: cards([2]int{3, 4}, [2]int{8, 9}), CanReverseHand: true, CanScoutAndShow: true},
+			{Hand: cards([2]int{5, 6}, [2]int{1, 2}), CanReverseHand: true, CanScoutAndShow: true},
+		},
+	}, 1)
+	if err != nil {
+		t.Fatalf("NewGameFromState returned err: %v", err)
+	}
+	game.PlayerAction(0, &ActionSpec{Type: ActionShow, ShowFirstIndex: 0, ShowLength: 1})
+
+	imported, err := RestoreGame(game.Snapshot())
+	if err != nil {
+		t.Fatalf("RestoreGame returned err: %v", err)
+	}
+	store.Add(imported)
+
+	reloaded, err := NewFileStore(dir)
+	if err != nil {
+		t.Fatalf("NewFileStore returned err: %v", err)
+	}
+	again := reloaded.Get(imported.Id)
+	for i := range imported.Players {
+		if !reflect.DeepEqual(again.Players[i].Hand, imported.Players[i].Hand) {
+			t.Fatalf("seat %d: expected the imported hand, got %v", i, again.Players[i].Hand)
+		}
+	}
+	if !reflect.DeepEqual(again.ActiveSet, imported.ActiveSet) || again.ActivePlayer.Index != imported.ActivePlayer.Index {
+		t.Fatalf("expected the imported table")
+	}
+}
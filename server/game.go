@@ -1,9 +1,10 @@
 package server
 
 import (
-	"fmt"
+	"math/rand/v2"
 	"strconv"
 	"sync"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -18,10 +19,48 @@ type Game struct {
 	ConsecutiveScouts int
 	Round             int
 	Complete          bool
+	Lobby             bool       // waiting for players to join; see NewOpenGame
+	Seed              int64      // seeds every deal, so a game can be replayed
+	Start             *GameState // the position the game started from, if not a deal
+	Config            GameConfig
+	History           []Move // every action applied, in order
+	CreatedAt         time.Time
+	UpdatedAt         time.Time // when a player last did anything
+	tokens            []string  // per seat; see SeatToken
+	tokenHashes       []string  // per seat, the hash of its token, which is all a store keeps
+	owners            []string  // per seat, the client identity its token was handed to; see giveSeat
+	src               *rand.PCG
+	rng               *rand.Rand
 	mu                sync.RWMutex
+
+	// clocks; see Configure
+	turns        int64 // turns started, so a timer can tell if its turn is over
+	turnStarted  time.Time
+	clocks       []time.Duration // time left per seat, when the game has a total time
+	timer        *time.Timer
+	afterTimeout func()
+	onMove       func(Move) // called with g.mu held
+	closed       bool       // see Close
+
+	// watchers
+	sequence    int64
+	subMu       sync.Mutex
+	subscribers map[*Subscription]struct{}
+}
+
+// Move is an action a player took
+type Move struct {
+	PlayerIndex int
+	Action      ActionSpec
+	Timeout     bool // played by the timeout policy because the seat ran out of time
 }
 
 func NewGame(numPlayers int) (*Game, RulesViolation) {
+	return NewSeededGame(numPlayers, rand.Int64())
+}
+
+// NewSeededGame is NewGame, with every deal drawn from the given seed
+func NewSeededGame(numPlayers int, seed int64) (*Game, RulesViolation) {
 	// init players
 	players := make([]*Player, numPlayers)
 	for i := 0; i < numPlayers; i++ {
@@ -37,6 +76,17 @@ func NewGame(numPlayers int) (*Game, RulesViolation) {
 		NumPlayers:   numPlayers,
 		Players:      players,
 		ActivePlayer: players[0],
+		Seed:         seed,
+		src:          rand.NewPCG(uint64(seed), 0),
+		tokens:       make([]string, numPlayers),
+		tokenHashes:  make([]string, numPlayers),
+		owners:       make([]string, numPlayers),
+		CreatedAt:    time.Now(),
+	}
+	g.UpdatedAt = g.CreatedAt
+	g.rng = rand.New(g.src)
+	for i := range g.tokens {
+		g.newSeatToken(i)
 	}
 
 	g.dealHands()
@@ -48,16 +98,38 @@ func (g *Game) PlayerAction(playerIndex int, action *ActionSpec) RulesViolation
 	g.mu.Lock()
 	defer g.mu.Unlock()
 
+	return g.playerAction(playerIndex, action, false)
+}
+
+// playerAction applies the action; timeout marks it as played for a seat that ran out of
+// time. must be called with g.mu held.
+func (g *Game) playerAction(playerIndex int, action *ActionSpec, timeout bool) RulesViolation {
 	if g.Complete {
-		return RulesViolation(fmt.Errorf("game is complete"))
+		return errGameComplete
+	}
+
+	if g.Lobby {
+		return errGameNotStarted
 	}
 
 	if playerIndex != g.ActivePlayer.Index {
-		return RulesViolation(fmt.Errorf("not your turn"))
+		return errNotYourTurn
+	}
+
+	if err := g.checkAction(playerIndex, action); err != nil {
+		return errActionInvalid(err)
 	}
 
-	if !g.IsActionValid(playerIndex, action) {
-		return RulesViolation(fmt.Errorf("action is invalid"))
+	// remember what the table sees, for watchers
+	before := g.scores()
+	var scouted *Card
+	switch action.Type {
+	case ActionScout, ActionScoutReverse, ActionScoutAndShow, ActionScoutAndShowReverse:
+		card := *g.ActiveSet[action.ScoutTakeIndex]
+		if action.Type == ActionScoutReverse || action.Type == ActionScoutAndShowReverse {
+			card.ReverseValues()
+		}
+		scouted = &card
 	}
 
 	var err RulesViolation
@@ -75,162 +147,282 @@ func (g *Game) PlayerAction(playerIndex int, action *ActionSpec) RulesViolation
 		err = g.scoutAndShowActionReverse(action.ScoutTakeIndex, action.ScoutPutIndex, action.ShowFirstIndex, action.ShowLength)
 	case ActionReverseHand:
 		g.ActivePlayer.ReverseHand()
+		g.record(Move{PlayerIndex: playerIndex, Action: *action, Timeout: timeout})
+		if l := g.debugLogger(); l != nil {
+			l.Debug("hand reversed", "player_index", playerIndex, "hand", formatCards(g.ActivePlayer.Hand), "timeout", timeout)
+		}
+		g.publishAction(playerIndex, action, nil, nil, before, false, timeout)
 		return nil
 	default:
-		return RulesViolation(fmt.Errorf("unknown action"))
+		return errUnknownAction
 	}
 
 	if err != nil {
 		return err
 	}
+	g.record(Move{PlayerIndex: playerIndex, Action: *action, Timeout: timeout})
+	g.stopTurn()
+	l := g.debugLogger()
+	if l != nil {
+		l.Debug("action applied", "player_index", playerIndex, "action", action.Format(), "type", action.Type, "timeout", timeout,
+			"hand", formatCards(g.ActivePlayer.Hand), "active_set", formatCards(g.ActiveSet), "consecutive_scouts", g.ConsecutiveScouts, "score", g.ActivePlayer.Score)
+	}
+
+	var shown []*Card
+	switch action.Type {
+	case ActionShow, ActionScoutAndShow, ActionScoutAndShowReverse:
+		shown = cloneCards(g.ActiveSet)
+	}
 
 	// prevent reverse hand after the first round
 	g.ActivePlayer.CanReverseHand = false
 
 	if g.checkRoundCompletion(); g.Complete {
 		g.calculateScores()
+		if l != nil {
+			l.Debug("round ended", "round", g.Round, "scores", g.scores())
+		}
 		if g.checkGameCompletion(); g.Complete {
+			if l != nil {
+				l.Debug("game ended", "scores", g.scores())
+			}
+			g.publishAction(playerIndex, action, scouted, shown, before, true, timeout)
 			return nil // game over
 		} else {
 			g.resetNextRound()
 		}
+		g.startTurn()
+		g.publishAction(playerIndex, action, scouted, shown, before, true, timeout)
 	} else {
 		// set the next active player
 		g.ActivePlayer = g.Players[(g.ActivePlayer.Index+1)%len(g.Players)]
+		if l != nil {
+			l.Debug("turn passed", "player_index", g.ActivePlayer.Index)
+		}
+		g.startTurn()
+		g.publishAction(playerIndex, action, scouted, shown, before, false, timeout)
 	}
 
 	return nil
 }
 
+// record adds the move to the game's history. must be called with g.mu held.
+func (g *Game) record(move Move) {
+	g.History = append(g.History, move)
+	g.UpdatedAt = time.Now()
+	if g.onMove != nil {
+		g.onMove(move)
+	}
+}
+
+// Close stops the game's clock and ends every subscription, for a game that is being thrown away
+func (g *Game) Close() {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.closed = true
+	if g.timer != nil {
+		g.timer.Stop()
+		g.timer = nil
+	}
+
+	g.subMu.Lock()
+	defer g.subMu.Unlock()
+	for sub := range g.subscribers {
+		delete(g.subscribers, sub)
+		close(sub.c)
+	}
+}
+
 func (g *Game) IsActionValid(playerIndex int, action *ActionSpec) bool {
+	return g.checkAction(playerIndex, action) == nil
+}
+
+// checkAction returns the rule the action would break, or nil if the player can take it.
+// it doesn't check whose turn it is.
+func (g *Game) checkAction(playerIndex int, action *ActionSpec) RulesViolation {
 	p := g.Players[playerIndex]
 
 	switch action.Type {
-	case ActionScout:
-		return g.isValidScout(p, action.ScoutTakeIndex, action.ScoutPutIndex)
-	case ActionScoutReverse:
-		return g.isValidScout(p, action.ScoutTakeIndex, action.ScoutPutIndex)
+	case ActionScout, ActionScoutReverse:
+		return g.checkScout(p, action.ScoutTakeIndex, action.ScoutPutIndex)
 	case ActionShow:
-		return g.isValidShow(p.Hand, action.ShowFirstIndex, action.ShowLength)
-	case ActionScoutAndShow:
-		return g.isValidScoutAndShow(p, action.ScoutTakeIndex, action.ScoutPutIndex, action.ShowFirstIndex, action.ShowLength)
-	case ActionScoutAndShowReverse:
-		return g.isValidScoutAndShowReverse(p, action.ScoutTakeIndex, action.ScoutPutIndex, action.ShowFirstIndex, action.ShowLength)
+		return g.checkShow(p.Hand, action.ShowFirstIndex, action.ShowLength)
+	case ActionScoutAndShow, ActionScoutAndShowReverse:
+		return g.checkScoutAndShow(p, action.ScoutTakeIndex, action.ScoutPutIndex, action.ShowFirstIndex, action.ShowLength, action.Type == ActionScoutAndShowReverse)
 	case ActionReverseHand:
-		return p.CanReverseHand
+		if !p.CanReverseHand {
+			return errReverseHandNotAllowed
+		}
+		return nil
 	default:
-		return false
+		return errUnknownAction
 	}
 }
 
-func (g *Game) isValidScout(p *Player, takeIndex, putIndex int) bool {
+// ActionMask returns, for every action in the action space, whether IsActionValid allows it
+func (g *Game) ActionMask(playerIndex int) []bool {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	actions := AllActions()
+	mask := make([]bool, len(actions))
+	for _, action := range actions {
+		mask[action.ID] = g.IsActionValid(playerIndex, &action)
+	}
+	return mask
+}
+
+// turn returns the index of the active player, and the phase of the game
+func (g *Game) turn() (int, GamePhase) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	return g.ActivePlayer.Index, g.phase()
+}
+
+// seatTurn returns which turn is in progress, counted by startTurn, and whether it is the seat's
+func (g *Game) seatTurn(seat int) (int64, bool) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	return g.turns, g.phase() == PhasePlaying && g.ActivePlayer.Index == seat
+}
+
+// LegalActions returns every action in the action space that the player can take right now
+func (g *Game) LegalActions(playerIndex int) []ActionSpec {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	legal := make([]ActionSpec, 0)
+	if g.phase() != PhasePlaying || playerIndex != g.ActivePlayer.Index {
+		return legal
+	}
+	for _, action := range AllActions() {
+		if g.IsActionValid(playerIndex, &action) {
+			legal = append(legal, action)
+		}
+	}
+	return legal
+}
+
+func (g *Game) checkScout(p *Player, takeIndex, putIndex int) RulesViolation {
 	if len(g.ActiveSet) == 0 {
-		return false
+		return errNothingToScout
 	}
-	if putIndex > len(p.Hand) {
-		return false
+	if takeIndex < 0 || takeIndex >= len(g.ActiveSet) || putIndex < 0 || putIndex > len(p.Hand) {
+		return errIndexOutOfRange
 	}
 	// can only scout from the 'ends' of the active set
-	return !(takeIndex != 0 && takeIndex != len(g.ActiveSet)-1)
+	if takeIndex != 0 && takeIndex != len(g.ActiveSet)-1 {
+		return errScoutNotFromEnd
+	}
+	return nil
 }
 
-func (g *Game) isValidShow(hand []*Card, firstIndex, length int) bool {
-	if firstIndex < 0 || firstIndex+length > len(hand) {
-		return false
+func (g *Game) checkShow(hand []*Card, firstIndex, length int) RulesViolation {
+	if firstIndex < 0 || length < 0 || firstIndex+length > len(hand) {
+		return errIndexOutOfRange
 	}
 	set := hand[firstIndex : firstIndex+length]
-	err := validateSet(set)
-	if err != nil {
-		return false
+	if err := validateSet(set); err != nil {
+		return err
+	}
+	if !setComparison(set, g.ActiveSet) {
+		return errSetTooWeak
 	}
-	return setComparison(set, g.ActiveSet)
+	return nil
 }
 
-func (g *Game) isValidScoutAndShow(p *Player, takeIndex, putIndex, startIndex, length int) bool {
+func (g *Game) checkScoutAndShow(p *Player, takeIndex, putIndex, startIndex, length int, reverse bool) RulesViolation {
 	if !p.CanScoutAndShow {
-		return false
+		return errScoutAndShowUsed
 	}
-	if g.isValidScout(p, takeIndex, putIndex) {
-		// assemble the hand as it would be after scout
-		hand := make([]*Card, len(p.Hand))
-		copy(hand, p.Hand)
-		cardCopy := &Card{
-			Value1: g.ActiveSet[takeIndex].Value1,
-			Value2: g.ActiveSet[takeIndex].Value2,
-		}
-		hand = append(hand[:putIndex], append([]*Card{cardCopy}, hand[putIndex:]...)...)
-		return g.isValidShow(hand, startIndex, length)
+	if err := g.checkScout(p, takeIndex, putIndex); err != nil {
+		return err
 	}
-	return false
-}
-
-func (g *Game) isValidScoutAndShowReverse(p *Player, takeIndex, putIndex, startIndex, length int) bool {
-	if !p.CanScoutAndShow {
-		return false
+	// assemble the hand as it would be after scout
+	hand := make([]*Card, len(p.Hand))
+	copy(hand, p.Hand)
+	cardCopy := &Card{
+		Value1: g.ActiveSet[takeIndex].Value1,
+		Value2: g.ActiveSet[takeIndex].Value2,
 	}
-	if g.isValidScout(p, takeIndex, putIndex) {
-		// assemble the hand as it would be after scout
-		hand := make([]*Card, len(p.Hand))
-		copy(hand, p.Hand)
-		cardCopy := &Card{
-			Value1: g.ActiveSet[takeIndex].Value1,
-			Value2: g.ActiveSet[takeIndex].Value2,
-		}
+	if reverse {
 		cardCopy.ReverseValues()
-		hand = append(hand[:putIndex], append([]*Card{cardCopy}, hand[putIndex:]...)...)
-		return g.isValidShow(hand, startIndex, length)
 	}
-	return false
+	hand = append(hand[:putIndex], append([]*Card{cardCopy}, hand[putIndex:]...)...)
+	return g.checkShow(hand, startIndex, length)
 }
 
 // returns true if set beats compSet, false otherwise
 func setComparison(set, compSet []*Card) bool {
+	return compareSets(set, compSet) == setBeats
+}
+
+// setOutcome is how a set fares against the active set: it beats it, or the rule it loses by
+type setOutcome int
+
+const (
+	setBeats            setOutcome = iota
+	setShorter                     // the set has fewer cards
+	setConsecutive                 // the set is consecutive, the active set matching
+	setLowestValueLower            // the sets are alike, and the set's lowest value is no higher
+)
+
+// compareSets says whether set beats compSet, and if not, which rule it loses by. ExplainAction
+// reports the same rule, so the two never disagree.
+func compareSets(set, compSet []*Card) setOutcome {
 	// always beat the empty set
 	if len(compSet) == 0 {
-		return true
+		return setBeats
 	}
 
 	// always beat a smaller set
 	if len(set) > len(compSet) {
-		return true
+		return setBeats
 	} else if len(set) < len(compSet) {
-		return false
+		return setShorter
 	}
 
 	// matching beats consecutive
-	isSetConsecutive := func(s []*Card) bool {
-		if len(s) < 2 {
-			return false
-		}
-		return s[0].Value1 != s[1].Value1
-	}
 	if !isSetConsecutive(set) {
 		if isSetConsecutive(compSet) {
-			return true
+			return setBeats
 		}
 	} else {
 		if !isSetConsecutive(compSet) {
-			return false
+			return setConsecutive
 		}
 	}
 
 	// lowest number is tie breaker
-	minValue := func(s []*Card) int {
-		min := s[0].Value1
-		for _, card := range s {
-			if card.Value1 < min {
-				min = card.Value1
-			}
-		}
-		return min
+	if minValue(set) > minValue(compSet) {
+		return setBeats
 	}
+	return setLowestValueLower
+}
 
-	return minValue(set) > minValue(compSet)
+func isSetConsecutive(s []*Card) bool {
+	if len(s) < 2 {
+		return false
+	}
+	return s[0].Value1 != s[1].Value1
+}
+
+func minValue(s []*Card) int {
+	min := s[0].Value1
+	for _, card := range s {
+		if card.Value1 < min {
+			min = card.Value1
+		}
+	}
+	return min
 }
 
 // dealHands deals cards to each player; players get same number of cards
 func (g *Game) dealHands() {
-	deck, _ := NewGameDeck(g.NumPlayers)
+	deck, _ := newSeededGameDeck(g.NumPlayers, g.rng)
 	for i := 0; i < len(deck); i++ {
 		g.Players[i%g.NumPlayers].Hand = append(g.Players[i%g.NumPlayers].Hand, deck[i])
 	}
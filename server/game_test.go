@@ -244,3 +244,97 @@ func TestValidateSet(t *testing.T) {
 		}
 	}
 }
+
+func TestPublicCards(t *testing.T) {
+	game, err := NewGame(3)
+	if err != nil {
+		t.Fatalf("NewGame returned err: %v", err)
+	}
+	game.Players[0].Hand = []*Card{{Value1: 1, Value2: 2}, {Value1: 5, Value2: 6}}
+	game.Players[1].Hand = []*Card{{Value1: 3, Value2: 4}, {Value1: 4, Value2: 5}, {Value1: 7, Value2: 8}}
+	game.Players[2].Hand = []*Card{{Value1: 9, Value2: 1}, {Value1: 2, Value2: 3}}
+
+	type step struct {
+		player int
+		action ActionSpec
+	}
+	play := func(steps ...step) {
+		for i, s := range steps {
+			if err := game.PlayerAction(s.player, &s.action); err != nil {
+				t.Fatalf("step %d returned err: %v", i, err)
+			}
+		}
+	}
+
+	play(
+		step{0, ActionSpec{Type: ActionShow, ShowFirstIndex: 0, ShowLength: 1}},
+		step{1, ActionSpec{Type: ActionScoutReverse, ScoutTakeIndex: 0, ScoutPutIndex: 2}},
+	)
+	public := game.ToProto().PlayerStates[1].PublicCards
+	if len(public) != 1 {
+		t.Fatalf("expected 1 public card, got %d", len(public))
+	}
+	if public[0].Position != 2 || public[0].Card.Value1 != 2 || public[0].Card.Value2 != 1 {
+		t.Fatalf("expected public card 2/1 at position 2, got %v", public[0])
+	}
+
+	// showing the cards in front of it moves the public card to the front
+	play(
+		step{2, ActionSpec{Type: ActionShow, ShowFirstIndex: 0, ShowLength: 1}},
+		step{0, ActionSpec{Type: ActionScout, ScoutTakeIndex: 0, ScoutPutIndex: 0}},
+		step{1, ActionSpec{Type: ActionShow, ShowFirstIndex: 0, ShowLength: 2}},
+	)
+	states := game.ToProto().PlayerStates
+	public = states[1].PublicCards
+	if len(public) != 1 || public[0].Position != 0 {
+		t.Fatalf("expected public card at position 0, got %v", public)
+	}
+	public = states[0].PublicCards
+	if len(public) != 1 || public[0].Position != 0 || public[0].Card.Value1 != 9 {
+		t.Fatalf("expected public card 9/1 at position 0 for player 1, got %v", public)
+	}
+}
+
+func TestViolationCodes(t *testing.T) {
+	tests := []struct {
+		name     string
+		position string
+		player   int
+		action   ActionSpec
+		expected ViolationCode
+	}{
+		{"not your turn", "set=- seat=5/6:0:RS seat=7/8:0:RS", 1, ActionSpec{Type: ActionShow, ShowLength: 1}, ViolationNotYourTurn},
+		{"unknown action", "set=- seat=5/6:0:RS seat=7/8:0:RS", 0, ActionSpec{Type: 99}, ViolationUnknownAction},
+		{"nothing to scout", "set=- seat=5/6:0:RS seat=7/8:0:RS", 0, ActionSpec{Type: ActionScout}, ViolationNothingToScout},
+		{"scout from the middle", "set=2/10,3/10,4/10 owner=1 seat=5/6:0:RS seat=7/8:0:RS", 0, ActionSpec{Type: ActionScout, ScoutTakeIndex: 1}, ViolationScoutNotFromEnd},
+		{"scout past the end", "set=2/10,3/10,4/10 owner=1 seat=5/6:0:RS seat=7/8:0:RS", 0, ActionSpec{Type: ActionScout, ScoutTakeIndex: 5}, ViolationIndexOutOfRange},
+		{"scout into a negative slot", "set=2/10 owner=1 seat=5/6:0:RS seat=7/8:0:RS", 0, ActionSpec{Type: ActionScout, ScoutPutIndex: -1}, ViolationIndexOutOfRange},
+		{"show past the end", "set=- seat=5/6:0:RS seat=7/8:0:RS", 0, ActionSpec{Type: ActionShow, ShowLength: 2}, ViolationIndexOutOfRange},
+		{"show nothing", "set=- seat=5/6:0:RS seat=7/8:0:RS", 0, ActionSpec{Type: ActionShow}, ViolationSetEmpty},
+		{"show a broken set", "set=- seat=5/6,7/8:0:RS seat=1/9:0:RS", 0, ActionSpec{Type: ActionShow, ShowLength: 2}, ViolationSetNotConsecutiveOrMatching},
+		{"show a weak set", "set=2/10,3/10 owner=1 seat=5/6:0:RS seat=7/8:0:RS", 0, ActionSpec{Type: ActionShow, ShowLength: 1}, ViolationSetTooWeak},
+		{"scout and show twice", "set=2/10 owner=1 seat=5/6:0:R seat=7/8:0:RS", 0, ActionSpec{Type: ActionScoutAndShow, ShowLength: 1}, ViolationScoutAndShowUsed},
+		{"reverse hand late", "set=- seat=5/6:0:S seat=7/8:0:RS", 0, ActionSpec{Type: ActionReverseHand}, ViolationReverseHandNotAllowed},
+	}
+
+	for _, test := range tests {
+		state, err := ParsePosition(test.position)
+		if err != nil {
+			t.Fatalf("%s: ParsePosition returned err: %v", test.name, err)
+		}
+		game, err := NewGameFromState(state, 1)
+		if err != nil {
+			t.Fatalf("%s: NewGameFromState returned err: %v", test.name, err)
+		}
+		err = game.PlayerAction(test.player, &test.action)
+		if code := ViolationCodeOf(err); code != test.expected {
+			t.Fatalf("%s: expected violation %d, got %d (%v)", test.name, test.expected, code, err)
+		}
+	}
+
+	game, _ := NewGame(2)
+	game.Complete = true
+	if code := ViolationCodeOf(game.PlayerAction(0, &ActionSpec{Type: ActionReverseHand})); code != ViolationGameComplete {
+		t.Fatalf("expected ViolationGameComplete, got %d", code)
+	}
+}
@@ -0,0 +1,270 @@
+package server
+
+import (
+	"context"
+	"io"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gorilla/websocket"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	pb "scout-go/proto"
+)
+
+const MAX_GATEWAY_BODY = 4 << 20
+
+// messages are written with their proto field names, zero values included
+var gatewayMarshal = protojson.MarshalOptions{UseProtoNames: true, EmitUnpopulated: true}
+
+// Gateway serves ScoutService over HTTP with JSON: POST /v1/<Method> with the request message
+// in protojson, e.g. POST /v1/CreateGame {"num_players": 3}. calls go through the same
+// interceptors as gRPC ones, and headers are passed on as metadata, so x-seat-token and
+// x-admin-token work as they do over gRPC. WatchGame streams its events as JSON lines, and
+// any stream, PlaySession included, can be opened as a WebSocket; see serveWebSocket.
+type Gateway struct {
+	AllowedOrigins []string // origins besides the gateway's own that may open WebSockets; "*" for any
+
+	server *ScoutServer
+	unary  grpc.UnaryServerInterceptor
+	stream grpc.StreamServerInterceptor
+}
+
+// NewGateway serves s, calling it through the interceptors in the order given
+func NewGateway(s *ScoutServer, unary []grpc.UnaryServerInterceptor, stream []grpc.StreamServerInterceptor) *Gateway {
+	return &Gateway{server: s, unary: chainUnary(unary), stream: chainStream(stream)}
+}
+
+func (gw *Gateway) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	name, ok := strings.CutPrefix(r.URL.Path, "/v1/")
+	if !ok {
+		writeGatewayError(w, status.Errorf(codes.NotFound, "no method at %s; call POST /v1/<Method>", r.URL.Path))
+		return
+	}
+	desc := pb.ScoutService_ServiceDesc
+	fullMethod := "/" + desc.ServiceName + "/" + name
+	if websocket.IsWebSocketUpgrade(r) {
+		for _, sd := range desc.Streams {
+			if sd.StreamName == name {
+				gw.serveWebSocket(w, r, sd, fullMethod)
+				return
+			}
+		}
+	}
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "methods are called with POST", http.StatusMethodNotAllowed)
+		return
+	}
+	body, err := io.ReadAll(io.LimitReader(r.Body, MAX_GATEWAY_BODY))
+	if err != nil {
+		writeGatewayError(w, status.Errorf(codes.InvalidArgument, "failed to read request body: %v", err))
+		return
+	}
+	ctx := gatewayContext(r)
+
+	for _, method := range desc.Methods {
+		if method.MethodName != name {
+			continue
+		}
+		resp, err := method.Handler(gw.server, ctx, func(m interface{}) error { return decodeGateway(body, m) }, gw.unary)
+		if err != nil {
+			writeGatewayError(w, err)
+			return
+		}
+		data, err := gatewayMarshal.Marshal(resp.(proto.Message))
+		if err != nil {
+			writeGatewayError(w, status.Errorf(codes.Internal, "failed to encode response: %v", err))
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(data)
+		return
+	}
+	for _, sd := range desc.Streams {
+		if sd.StreamName != name || !sd.ServerStreams || sd.ClientStreams {
+			continue
+		}
+		stream := &gatewayStream{ctx: ctx, w: w, body: body}
+		info := &grpc.StreamServerInfo{FullMethod: fullMethod, IsServerStream: true}
+		if gw.stream != nil {
+			err = gw.stream(gw.server, stream, info, sd.Handler)
+		} else {
+			err = sd.Handler(gw.server, stream)
+		}
+		if err == nil {
+			return
+		}
+		if !stream.sent {
+			writeGatewayError(w, err)
+			return
+		}
+		// the status is already sent, so the error ends the stream as a last line
+		w.Write(append(gatewayErrorMessage(err), '\n'))
+		return
+	}
+	writeGatewayError(w, status.Errorf(codes.NotFound, "unknown method %s", name))
+}
+
+// gatewayContext gives the request's headers to the handler as metadata, and its client and
+// certificate as the peer, as a gRPC call would
+func gatewayContext(r *http.Request) context.Context {
+	md := metadata.MD{}
+	for key, values := range r.Header {
+		md.Append(key, values...)
+	}
+	p := &peer.Peer{Addr: gatewayAddr(r.RemoteAddr)}
+	if r.TLS != nil {
+		p.AuthInfo = credentials.TLSInfo{State: *r.TLS, CommonAuthInfo: credentials.CommonAuthInfo{SecurityLevel: credentials.PrivacyAndIntegrity}}
+	}
+	return peer.NewContext(metadata.NewIncomingContext(r.Context(), md), p)
+}
+
+// gatewayAddr is an HTTP client's address
+type gatewayAddr string
+
+func (a gatewayAddr) Network() string { return "tcp" }
+func (a gatewayAddr) String() string  { return string(a) }
+
+func decodeGateway(body []byte, m interface{}) error {
+	if len(body) == 0 {
+		return nil
+	}
+	if err := protojson.Unmarshal(body, m.(proto.Message)); err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid request body: %v", err)
+	}
+	return nil
+}
+
+// writeGatewayError writes the error's status as JSON, with the HTTP status closest to its code
+func writeGatewayError(w http.ResponseWriter, err error) {
+	st := status.Convert(err)
+	for _, detail := range st.Details() {
+		if retry, ok := detail.(*errdetails.RetryInfo); ok {
+			w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(retry.RetryDelay.AsDuration().Seconds()))))
+		}
+	}
+	data, _ := gatewayMarshal.Marshal(st.Proto())
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(httpStatus(st.Code()))
+	w.Write(data)
+}
+
+// gatewayErrorMessage is how a stream that has begun ends with an error: {"error": <status>}
+func gatewayErrorMessage(err error) []byte {
+	data, _ := gatewayMarshal.Marshal(status.Convert(err).Proto())
+	return append(append([]byte(`{"error":`), data...), '}')
+}
+
+// httpStatus maps a gRPC status code to an HTTP status, as grpc-gateway does
+func httpStatus(code codes.Code) int {
+	switch code {
+	case codes.OK:
+		return http.StatusOK
+	case codes.Canceled:
+		return 499 // client closed request
+	case codes.InvalidArgument, codes.FailedPrecondition, codes.OutOfRange:
+		return http.StatusBadRequest
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists, codes.Aborted:
+		return http.StatusConflict
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	case codes.Unimplemented:
+		return http.StatusNotImplemented
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// gatewayStream is a server stream over an HTTP response: the request body is its one message,
+// and each message it sends is written as a line of JSON
+type gatewayStream struct {
+	ctx      context.Context
+	w        http.ResponseWriter
+	body     []byte
+	received bool
+	sent     bool
+}
+
+func (s *gatewayStream) SetHeader(metadata.MD) error  { return nil }
+func (s *gatewayStream) SendHeader(metadata.MD) error { return nil }
+func (s *gatewayStream) SetTrailer(metadata.MD)       {}
+func (s *gatewayStream) Context() context.Context     { return s.ctx }
+
+func (s *gatewayStream) RecvMsg(m interface{}) error {
+	if s.received {
+		return io.EOF
+	}
+	s.received = true
+	return decodeGateway(s.body, m)
+}
+
+func (s *gatewayStream) SendMsg(m interface{}) error {
+	data, err := gatewayMarshal.Marshal(m.(proto.Message))
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to encode message: %v", err)
+	}
+	if !s.sent {
+		s.w.Header().Set("Content-Type", "application/x-ndjson")
+		s.sent = true
+	}
+	if _, err := s.w.Write(append(data, '\n')); err != nil {
+		return err
+	}
+	if f, ok := s.w.(http.Flusher); ok {
+		f.Flush()
+	}
+	return nil
+}
+
+func chainUnary(interceptors []grpc.UnaryServerInterceptor) grpc.UnaryServerInterceptor {
+	if len(interceptors) == 0 {
+		return nil
+	}
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		next := handler
+		for i := len(interceptors) - 1; i > 0; i-- {
+			interceptor, inner := interceptors[i], next
+			next = func(ctx context.Context, req interface{}) (interface{}, error) {
+				return interceptor(ctx, req, info, inner)
+			}
+		}
+		return interceptors[0](ctx, req, info, next)
+	}
+}
+
+func chainStream(interceptors []grpc.StreamServerInterceptor) grpc.StreamServerInterceptor {
+	if len(interceptors) == 0 {
+		return nil
+	}
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		next := handler
+		for i := len(interceptors) - 1; i > 0; i-- {
+			interceptor, inner := interceptors[i], next
+			next = func(srv interface{}, ss grpc.ServerStream) error {
+				return interceptor(srv, ss, info, inner)
+			}
+		}
+		return interceptors[0](srv, ss, info, next)
+	}
+}
@@ -0,0 +1,118 @@
+package server
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	pb "scout-go/proto"
+)
+
+func TestGateway(t *testing.T) {
+	s := NewScoutServer(WithAdminToken("admin"))
+	limiter, _ := NewRateLimiter(RateLimits{CreateRate: 1, CreateBurst: 1})
+	srv := httptest.NewServer(NewGateway(s, []grpc.UnaryServerInterceptor{limiter.UnaryInterceptor}, nil))
+	defer srv.Close()
+
+	call := func(method, token, body string) *http.Response {
+		req, _ := http.NewRequest(http.MethodPost, srv.URL+"/v1/"+method, strings.NewReader(body))
+		if token != "" {
+			req.Header.Set(SEAT_TOKEN_HEADER, token)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("%s: %v", method, err)
+		}
+		return resp
+	}
+	decode := func(resp *http.Response, m *pb.CreateGameResponse) {
+		defer resp.Body.Close()
+		data, _ := io.ReadAll(resp.Body)
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("expected 200, got %d: %s", resp.StatusCode, data)
+		}
+		if err := protojson.Unmarshal(data, m); err != nil {
+			t.Fatalf("failed to decode %s: %v", data, err)
+		}
+	}
+
+	created := &pb.CreateGameResponse{}
+	decode(call("CreateGame", "", `{"num_players": 2}`), created)
+	if len(created.SeatTokens) != 2 {
+		t.Fatalf("expected 2 seat tokens, got %v", created.SeatTokens)
+	}
+
+	// errors keep their gRPC status and details, under the closest HTTP status
+	tests := []struct {
+		method, token, body string
+		status              int
+		code                string
+	}{
+		{"GetValidActions", "", `{"game_id": "` + created.GameId + `", "player_index": 1}`, http.StatusForbidden, "PERMISSION_DENIED"},
+		{"PlayerAction", created.SeatTokens[1], `{"game_id": "` + created.GameId + `", "player_index": 1, "action": {}, "status_errors": true}`, http.StatusBadRequest, "FAILED_PRECONDITION"},
+		{"CreateGame", "", `{"num_players": 2}`, http.StatusTooManyRequests, "RESOURCE_EXHAUSTED"},
+		{"CreateGame", "", `{"players": 2}`, http.StatusBadRequest, "INVALID_ARGUMENT"},
+		{"PlaySession", "", ``, http.StatusNotFound, "NOT_FOUND"},
+		{"GetGameState", "", `{"game_id": "nope"}`, http.StatusNotFound, "NOT_FOUND"},
+		{"WatchGame", "", `{"game_id": "nope"}`, http.StatusNotFound, "NOT_FOUND"},
+		{"GetValidActions", "", `{"game_id": "` + created.GameId + `", "player_index": 7}`, http.StatusBadRequest, "INVALID_ARGUMENT"},
+	}
+	for _, test := range tests {
+		resp := call(test.method, test.token, test.body)
+		var body struct {
+			Code    int
+			Message string
+			Details []map[string]any
+		}
+		json.NewDecoder(resp.Body).Decode(&body)
+		resp.Body.Close()
+		if resp.StatusCode != test.status {
+			t.Fatalf("%s: expected HTTP %d, got %d: %s", test.method, test.status, resp.StatusCode, body.Message)
+		}
+		if test.code == "RESOURCE_EXHAUSTED" && resp.Header.Get("Retry-After") != "1" {
+			t.Fatalf("expected a Retry-After header, got %q", resp.Header.Get("Retry-After"))
+		}
+		if test.code == "FAILED_PRECONDITION" && (len(body.Details) != 1 || body.Details[0]["code"] != "ViolationNotYourTurn") {
+			t.Fatalf("expected the rule violation in the details, got %v", body.Details)
+		}
+	}
+
+	// WatchGame streams events as lines of JSON
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	req, _ := http.NewRequestWithContext(ctx, http.MethodPost, srv.URL+"/v1/WatchGame", strings.NewReader(`{"game_id": "`+created.GameId+`", "spectator": true}`))
+	responses := make(chan *http.Response, 1)
+	go func() {
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			close(responses)
+			return
+		}
+		responses <- resp
+	}()
+	game := s.store.Get(created.GameId)
+	waitForSubscribers(t, game, 1)
+	call("PlayerAction", created.SeatTokens[0], `{"game_id": "`+created.GameId+`", "action": {"action_type": "ActionShow", "show_length": 1}}`).Body.Close()
+
+	resp := <-responses
+	if resp == nil {
+		t.Fatalf("WatchGame failed")
+	}
+	defer resp.Body.Close()
+	line, err := bufio.NewReader(resp.Body).ReadBytes('\n')
+	if err != nil {
+		t.Fatalf("failed to read an event: %v", err)
+	}
+	event := &pb.GameEvent{}
+	if err := protojson.Unmarshal(line, event); err != nil || event.EventType != pb.GameEvent_EventActionApplied || event.Action.ActionType != pb.Action_ActionShow {
+		t.Fatalf("expected the show, got %s (%v)", line, err)
+	}
+}
@@ -0,0 +1,116 @@
+package server
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+	"strings"
+
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+)
+
+// unmapped certificates are known by their common name behind this prefix, so that a
+// certificate can't pass for a mapped identity by taking its name as its common name
+const UNMAPPED_IDENTITY_PREFIX = "cn:"
+
+// ClientIdentities maps the subjects of client certificates, as pkix.Name.String writes them
+// (e.g. "CN=worker-1,O=team-a"), to the identities they act as. a certificate whose subject
+// isn't mapped acts as "cn:<common name>".
+type ClientIdentities map[string]string
+
+// Identify returns the identity of the caller's verified client certificate, or "" if it
+// didn't present one
+func (ids ClientIdentities) Identify(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return ""
+	}
+	info, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(info.State.VerifiedChains) == 0 || len(info.State.VerifiedChains[0]) == 0 {
+		return ""
+	}
+	subject := info.State.VerifiedChains[0][0].Subject
+	if id, ok := ids[subject.String()]; ok {
+		return id
+	}
+	return UNMAPPED_IDENTITY_PREFIX + subject.CommonName
+}
+
+// WithClientIdentities names callers by their client certificates, and gives the identities
+// in admins the admin role. only identities that ids maps subjects to can be admins. a caller
+// handed a seat's token can then act for the seat by its certificate alone.
+func WithClientIdentities(ids ClientIdentities, admins ...string) Option {
+	return func(s *ScoutServer) {
+		s.identities = ids
+		s.adminIdentities = make(map[string]bool)
+		for _, id := range admins {
+			if ids.maps(id) {
+				s.adminIdentities[id] = true
+			}
+		}
+	}
+}
+
+// maps reports whether some subject is mapped to the identity
+func (ids ClientIdentities) maps(identity string) bool {
+	if strings.HasPrefix(identity, UNMAPPED_IDENTITY_PREFIX) {
+		return false
+	}
+	for _, id := range ids {
+		if id == identity {
+			return true
+		}
+	}
+	return false
+}
+
+// MutualTLSConfig serves the certificate in certFile and keyFile, and requires every client
+// to present a certificate signed by a CA in caFile
+func MutualTLSConfig(certFile, keyFile, caFile string) (*tls.Config, error) {
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, err
+	}
+	pem, err := os.ReadFile(caFile)
+	if err != nil {
+		return nil, err
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("no certificates found in %s", caFile)
+	}
+	return &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		ClientCAs:    pool,
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		MinVersion:   tls.VersionTLS12,
+	}, nil
+}
+
+// giveSeat records that the caller was handed the seat's token, so its client certificate
+// can stand in for the token
+func (s *ScoutServer) giveSeat(ctx context.Context, game *Game, playerIndex int) {
+	id := s.identities.Identify(ctx)
+	if id == "" {
+		return
+	}
+	game.mu.Lock()
+	defer game.mu.Unlock()
+
+	game.owners[playerIndex] = id
+}
+
+// ownsSeat reports whether the caller's client certificate was handed the seat
+func (s *ScoutServer) ownsSeat(ctx context.Context, game *Game, playerIndex int) bool {
+	id := s.identities.Identify(ctx)
+	if id == "" {
+		return false
+	}
+	game.mu.RLock()
+	defer game.mu.RUnlock()
+
+	return playerIndex >= 0 && playerIndex < len(game.owners) && game.owners[playerIndex] == id
+}
@@ -0,0 +1,172 @@
+package server
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	pb "scout-go/proto"
+)
+
+// testCA signs certificates for the mTLS tests
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	pem  []byte
+}
+
+func newTestCA(t *testing.T) *testCA {
+	key, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("failed to make CA: %v", err)
+	}
+	cert, _ := x509.ParseCertificate(der)
+	return &testCA{cert: cert, key: key, pem: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})}
+}
+
+// issue returns a certificate for subject signed by the CA, as PEM
+func (ca *testCA) issue(t *testing.T, subject pkix.Name, usage x509.ExtKeyUsage) (certPEM, keyPEM []byte) {
+	key, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      subject,
+		DNSNames:     []string{"localhost"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.cert, &key.PublicKey, ca.key)
+	if err != nil {
+		t.Fatalf("failed to issue certificate: %v", err)
+	}
+	keyDER, _ := x509.MarshalECPrivateKey(key)
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+}
+
+func writeFile(t *testing.T, name string, data []byte) string {
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, data, 0600); err != nil {
+		t.Fatalf("failed to write %s: %v", name, err)
+	}
+	return path
+}
+
+func TestMutualTLSIdentities(t *testing.T) {
+	ca := newTestCA(t)
+	serverCert, serverKey := ca.issue(t, pkix.Name{CommonName: "localhost"}, x509.ExtKeyUsageServerAuth)
+	config, err := MutualTLSConfig(writeFile(t, "server.pem", serverCert), writeFile(t, "server.key", serverKey), writeFile(t, "ca.pem", ca.pem))
+	if err != nil {
+		t.Fatalf("MutualTLSConfig returned err: %v", err)
+	}
+
+	s := NewScoutServer(WithClientIdentities(ClientIdentities{
+		"CN=worker-1,O=team-a": "team-a",
+		"CN=worker-2,O=team-a": "team-a",
+		"CN=alice,O=ops":       "ops",
+	}, "ops"))
+	lis := bufconn.Listen(1 << 20)
+	srv := grpc.NewServer(grpc.Creds(credentials.NewTLS(config)))
+	pb.RegisterScoutServiceServer(srv, s)
+	go srv.Serve(lis)
+	t.Cleanup(srv.Stop)
+
+	roots := x509.NewCertPool()
+	roots.AddCert(ca.cert)
+	dial := func(subject *pkix.Name) pb.ScoutServiceClient {
+		tlsConfig := &tls.Config{RootCAs: roots, ServerName: "localhost"}
+		if subject != nil {
+			certPEM, keyPEM := ca.issue(t, *subject, x509.ExtKeyUsageClientAuth)
+			cert, _ := tls.X509KeyPair(certPEM, keyPEM)
+			tlsConfig.Certificates = []tls.Certificate{cert}
+		}
+		conn, err := grpc.NewClient("passthrough:///bufconn",
+			grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+			grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)))
+		if err != nil {
+			t.Fatalf("failed to dial: %v", err)
+		}
+		t.Cleanup(func() { conn.Close() })
+		return pb.NewScoutServiceClient(conn)
+	}
+	ctx := context.Background()
+
+	if _, err := dial(nil).ListGames(ctx, &pb.ListGamesRequest{}); err == nil {
+		t.Fatalf("expected a client without a certificate to be turned away")
+	}
+
+	// the team's workers share the seats their identity was handed, with no seat token
+	worker1, worker2 := dial(&pkix.Name{CommonName: "worker-1", Organization: []string{"team-a"}}), dial(&pkix.Name{CommonName: "worker-2", Organization: []string{"team-a"}})
+	created, err := worker1.CreateGame(ctx, &pb.CreateGameRequest{NumPlayers: 2})
+	if err != nil {
+		t.Fatalf("CreateGame returned err: %v", err)
+	}
+	if _, err := worker2.GetValidActions(ctx, &pb.GetValidActionsRequest{GameId: created.GameId, PlayerIndex: 1}); err != nil {
+		t.Fatalf("expected the team to hold every seat, got %v", err)
+	}
+	outsider := dial(&pkix.Name{CommonName: "team-b"})
+	if _, err := outsider.GetValidActions(ctx, &pb.GetValidActionsRequest{GameId: created.GameId, PlayerIndex: 0}); status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("expected another identity to be refused, got %v", err)
+	}
+
+	// a certificate named after a mapped identity, but not mapped itself, gets nothing of it
+	for _, name := range []string{"team-a", "ops"} {
+		impostor := dial(&pkix.Name{CommonName: name})
+		if _, err := impostor.GetValidActions(ctx, &pb.GetValidActionsRequest{GameId: created.GameId, PlayerIndex: 0}); status.Code(err) != codes.PermissionDenied {
+			t.Fatalf("expected an unmapped certificate named %s to be refused, got %v", name, err)
+		}
+		if _, err := impostor.DeleteGame(ctx, &pb.DeleteGameRequest{GameId: created.GameId}); status.Code(err) != codes.PermissionDenied {
+			t.Fatalf("expected an unmapped certificate named %s not to be admin, got %v", name, err)
+		}
+	}
+
+	// an admin identity acts for any seat, and can delete games
+	ops := dial(&pkix.Name{CommonName: "alice", Organization: []string{"ops"}})
+	if _, err := ops.GetValidActions(ctx, &pb.GetValidActionsRequest{GameId: created.GameId, PlayerIndex: 0}); err != nil {
+		t.Fatalf("expected the admin identity to act for any seat, got %v", err)
+	}
+	if _, err := ops.DeleteGame(ctx, &pb.DeleteGameRequest{GameId: created.GameId}); err != nil {
+		t.Fatalf("DeleteGame returned err: %v", err)
+	}
+
+	// joining a lobby seat hands it to the joiner's identity alone
+	open, _ := outsider.CreateGame(ctx, &pb.CreateGameRequest{NumPlayers: 2, Open: true})
+	joined, err := worker1.JoinGame(ctx, &pb.JoinGameRequest{GameId: open.GameId})
+	if err != nil {
+		t.Fatalf("JoinGame returned err: %v", err)
+	}
+	ready := &pb.SetReadyRequest{GameId: open.GameId, PlayerIndex: joined.PlayerIndex, Ready: true}
+	if _, err := outsider.SetReady(ctx, ready); status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("expected the creator of an open game not to hold its seats, got %v", err)
+	}
+	if _, err := worker2.SetReady(ctx, ready); err != nil {
+		t.Fatalf("SetReady returned err: %v", err)
+	}
+}
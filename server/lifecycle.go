@@ -0,0 +1,170 @@
+package server
+
+import (
+	"cmp"
+	"context"
+	"log/slog"
+	"slices"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "scout-go/proto"
+)
+
+const REAP_INTERVAL = time.Minute // the longest the reaper waits between sweeps
+
+// WithCompletedTTL removes complete games once nobody has touched them for ttl
+func WithCompletedTTL(ttl time.Duration) Option {
+	return func(s *ScoutServer) {
+		s.completedTTL = ttl
+	}
+}
+
+// WithIdleTTL removes games that are not complete once nobody has touched them for ttl
+func WithIdleTTL(ttl time.Duration) Option {
+	return func(s *ScoutServer) {
+		s.idleTTL = ttl
+	}
+}
+
+// WithMaxGames caps the number of games that are not complete; CreateGame fails beyond it
+func WithMaxGames(n int) Option {
+	return func(s *ScoutServer) {
+		s.maxGames = n
+	}
+}
+
+// Close stops the server's background work. it does not touch the games.
+func (s *ScoutServer) Close() {
+	s.closeOnce.Do(func() { close(s.done) })
+}
+
+func (s *ScoutServer) reapLoop() {
+	// sweep often enough that no game outlives its ttl by more than half again
+	interval := REAP_INTERVAL
+	for _, ttl := range []time.Duration{s.completedTTL, s.idleTTL} {
+		if ttl > 0 && ttl/2 < interval {
+			interval = ttl / 2
+		}
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.done:
+			return
+		case now := <-ticker.C:
+			s.reap(now)
+		}
+	}
+}
+
+// reap deletes the games whose ttl has run out
+func (s *ScoutServer) reap(now time.Time) {
+	games := s.store.List()
+
+	for _, game := range games {
+		game.mu.RLock()
+		complete, idle := game.Complete, now.Sub(game.UpdatedAt)
+		game.mu.RUnlock()
+
+		if (complete && s.completedTTL > 0 && idle >= s.completedTTL) ||
+			(!complete && s.idleTTL > 0 && idle >= s.idleTTL) {
+			slog.Info("game reaped", "game_id", game.Id, "idle", idle.Round(time.Second), "complete", complete)
+			s.deleteGame(game)
+		}
+	}
+}
+
+// checkGameLimit fails with ResourceExhausted if the server has no room for another live game.
+// must be called with s.addMu held.
+func (s *ScoutServer) checkGameLimit() error {
+	if s.maxGames > 0 && s.liveGames() >= s.maxGames {
+		return status.Errorf(codes.ResourceExhausted, "server is at its limit of %d live games", s.maxGames)
+	}
+	return nil
+}
+
+// liveGames counts the games that are not complete
+func (s *ScoutServer) liveGames() int {
+	n := 0
+	for _, game := range s.store.List() {
+		if game.Phase() != PhaseComplete {
+			n++
+		}
+	}
+	return n
+}
+
+// deleteGame removes the game from the server, ending its watchers and sessions and
+// disconnecting its agents
+func (s *ScoutServer) deleteGame(game *Game) {
+	if err := s.store.Delete(game.Id); err != nil {
+		slog.Error("failed to delete game from store", "game_id", game.Id, "err", err)
+		s.metrics.storeError("delete")
+	}
+
+	s.mu.Lock()
+	agents := s.agents[game.Id]
+	delete(s.agents, game.Id)
+	s.mu.Unlock()
+
+	game.Close()
+
+	if agents != nil {
+		agents.mu.Lock()
+		for _, seat := range agents.seats {
+			seat.conn.Close()
+		}
+		agents.seats = make(map[int]*agentSeat)
+		agents.mu.Unlock()
+	}
+}
+
+func (s *ScoutServer) ListGames(ctx context.Context, req *pb.ListGamesRequest) (*pb.ListGamesResponse, error) {
+	if req.MinAgeMs < 0 || req.MaxAgeMs < 0 || req.NumPlayers < 0 {
+		return nil, status.Error(codes.InvalidArgument, "filters must not be negative")
+	}
+
+	games := s.store.List()
+
+	now := time.Now()
+	minAge := time.Duration(req.MinAgeMs) * time.Millisecond
+	maxAge := time.Duration(req.MaxAgeMs) * time.Millisecond
+
+	resp := &pb.ListGamesResponse{}
+	for _, game := range games {
+		age := now.Sub(game.CreatedAt)
+		switch {
+		case len(req.Phases) > 0 && !slices.Contains(req.Phases, pb.GamePhase(game.Phase())):
+		case age < minAge:
+		case maxAge > 0 && age > maxAge:
+		case req.NumPlayers > 0 && game.NumPlayers != int(req.NumPlayers):
+		default:
+			resp.Games = append(resp.Games, game.ToProto())
+		}
+	}
+
+	// oldest first
+	slices.SortFunc(resp.Games, func(a, b *pb.Game) int {
+		return cmp.Compare(a.CreatedAtMs, b.CreatedAtMs)
+	})
+	return resp, nil
+}
+
+func (s *ScoutServer) DeleteGame(ctx context.Context, req *pb.DeleteGameRequest) (*pb.DeleteGameResponse, error) {
+	game := s.store.Get(req.GameId)
+
+	if game == nil {
+		return nil, errInvalidGameId
+	}
+	if !s.isAdmin(ctx) {
+		return nil, status.Error(codes.PermissionDenied, "only admins can delete games")
+	}
+
+	s.deleteGame(game)
+	return &pb.DeleteGameResponse{}, nil
+}
@@ -0,0 +1,166 @@
+package server
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	pb "scout-go/proto"
+)
+
+func TestListGames(t *testing.T) {
+	s := NewScoutServer()
+	ctx := context.Background()
+
+	two, _ := s.CreateGame(ctx, &pb.CreateGameRequest{NumPlayers: 2})
+	open, _ := s.CreateGame(ctx, &pb.CreateGameRequest{NumPlayers: 3, Open: true})
+	done, _ := s.CreateGame(ctx, &pb.CreateGameRequest{NumPlayers: 3})
+	s.store.Get(done.GameId).Complete = true
+	s.store.Get(two.GameId).CreatedAt = time.Now().Add(-time.Hour)
+
+	tests := []struct {
+		name     string
+		req      *pb.ListGamesRequest
+		expected []string
+	}{
+		{"everything, oldest first", &pb.ListGamesRequest{}, []string{two.GameId, open.GameId, done.GameId}},
+		{"lobby", &pb.ListGamesRequest{Phases: []pb.GamePhase{pb.GamePhase_PhaseLobby}}, []string{open.GameId}},
+		{"lobby or complete", &pb.ListGamesRequest{Phases: []pb.GamePhase{pb.GamePhase_PhaseLobby, pb.GamePhase_PhaseComplete}}, []string{open.GameId, done.GameId}},
+		{"three players", &pb.ListGamesRequest{NumPlayers: 3}, []string{open.GameId, done.GameId}},
+		{"older than a minute", &pb.ListGamesRequest{MinAgeMs: time.Minute.Milliseconds()}, []string{two.GameId}},
+		{"newer than a minute", &pb.ListGamesRequest{MaxAgeMs: time.Minute.Milliseconds()}, []string{open.GameId, done.GameId}},
+	}
+
+	for _, test := range tests {
+		resp, err := s.ListGames(ctx, test.req)
+		if err != nil {
+			t.Fatalf("%s: ListGames returned err: %v", test.name, err)
+		}
+		if len(resp.Games) != len(test.expected) {
+			t.Fatalf("%s: expected %d games, got %d", test.name, len(test.expected), len(resp.Games))
+		}
+		// games created in the same millisecond can come back in either order
+		found := make(map[string]bool)
+		for _, game := range resp.Games {
+			found[game.Id] = true
+		}
+		for _, id := range test.expected {
+			if !found[id] {
+				t.Fatalf("%s: expected game %s in %v", test.name, id, resp.Games)
+			}
+		}
+	}
+}
+
+func TestDeleteGame(t *testing.T) {
+	s := NewScoutServer(WithAdminToken("admin"))
+	client := dialServer(t, s)
+	ctx := context.Background()
+
+	created, _ := client.CreateGame(ctx, &pb.CreateGameRequest{NumPlayers: 2})
+	stream, err := client.WatchGame(ctx, &pb.WatchGameRequest{GameId: created.GameId, Spectator: true})
+	if err != nil {
+		t.Fatalf("WatchGame returned err: %v", err)
+	}
+	game := s.store.Get(created.GameId)
+	waitForSubscribers(t, game, 1)
+
+	// seats can't delete games, admins can
+	_, err = client.DeleteGame(dialAsSeat(created.SeatTokens[0]), &pb.DeleteGameRequest{GameId: created.GameId})
+	if status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("expected PermissionDenied, got %v", err)
+	}
+	admin := metadata.AppendToOutgoingContext(ctx, ADMIN_TOKEN_HEADER, "admin")
+	if _, err := client.DeleteGame(admin, &pb.DeleteGameRequest{GameId: created.GameId}); err != nil {
+		t.Fatalf("DeleteGame returned err: %v", err)
+	}
+
+	if _, err := stream.Recv(); status.Code(err) != codes.Aborted {
+		t.Fatalf("expected the watcher to be aborted, got %v", err)
+	}
+	if _, err := client.GetGameState(ctx, &pb.GetGameStateRequest{GameId: created.GameId}); err == nil {
+		t.Fatalf("expected the game to be gone")
+	}
+}
+
+func TestReap(t *testing.T) {
+	s := NewScoutServer(WithCompletedTTL(time.Minute), WithIdleTTL(time.Hour))
+	defer s.Close()
+	ctx := context.Background()
+
+	now := time.Now()
+	games := []struct {
+		complete bool
+		idle     time.Duration
+		reaped   bool
+	}{
+		{true, 2 * time.Minute, true},
+		{true, time.Second, false},
+		{false, 2 * time.Hour, true},
+		{false, 2 * time.Minute, false},
+	}
+	ids := make([]string, len(games))
+	for i, g := range games {
+		created, _ := s.CreateGame(ctx, &pb.CreateGameRequest{NumPlayers: 2})
+		ids[i] = created.GameId
+		s.store.Get(created.GameId).Complete = g.complete
+		s.store.Get(created.GameId).UpdatedAt = now.Add(-g.idle)
+	}
+
+	s.reap(now)
+
+	for i, g := range games {
+		if ok := s.store.Get(ids[i]) != nil; ok == g.reaped {
+			t.Fatalf("game %d: expected reaped=%v", i, g.reaped)
+		}
+	}
+}
+
+func TestMaxGames(t *testing.T) {
+	s := NewScoutServer(WithMaxGames(1))
+	ctx := context.Background()
+
+	first, err := s.CreateGame(ctx, &pb.CreateGameRequest{NumPlayers: 2})
+	if err != nil {
+		t.Fatalf("CreateGame returned err: %v", err)
+	}
+	if _, err := s.CreateGame(ctx, &pb.CreateGameRequest{NumPlayers: 2}); status.Code(err) != codes.ResourceExhausted {
+		t.Fatalf("expected ResourceExhausted, got %v", err)
+	}
+
+	// complete games don't count
+	s.store.Get(first.GameId).Complete = true
+	if _, err := s.CreateGame(ctx, &pb.CreateGameRequest{NumPlayers: 2}); err != nil {
+		t.Fatalf("CreateGame returned err: %v", err)
+	}
+}
+
+// slowStore takes a while to add a game, as a store that writes to disk does
+type slowStore struct{ GameStore }
+
+func (s slowStore) Add(game *Game) error {
+	time.Sleep(10 * time.Millisecond)
+	return s.GameStore.Add(game)
+}
+
+func TestMaxGamesConcurrent(t *testing.T) {
+	s := NewScoutServer(WithMaxGames(1), WithStore(slowStore{NewMemoryStore()}))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			s.CreateGame(context.Background(), &pb.CreateGameRequest{NumPlayers: 2})
+		}()
+	}
+	wg.Wait()
+	if n := s.liveGames(); n != 1 {
+		t.Fatalf("expected 1 live game, got %d", n)
+	}
+}
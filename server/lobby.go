@@ -0,0 +1,222 @@
+package server
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	pb "scout-go/proto"
+)
+
+const (
+	PhaseLobby GamePhase = iota
+	PhasePlaying
+	PhaseComplete
+)
+
+// GamePhase is where a game is in its lifecycle
+type GamePhase int
+
+const MAX_NAME_LENGTH = 32
+
+// NewOpenGame returns a game that waits in the lobby until every seat has been joined
+// and every player is ready
+func NewOpenGame(numPlayers int) (*Game, RulesViolation) {
+	g, err := NewGame(numPlayers)
+	if err != nil {
+		return nil, err
+	}
+	g.Lobby = true
+	for _, p := range g.Players {
+		p.Seated = false
+		g.revokeSeatToken(p.Index)
+	}
+	return g, nil
+}
+
+// Phase returns where the game is in its lifecycle
+func (g *Game) Phase() GamePhase {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	return g.phase()
+}
+
+func (g *Game) phase() GamePhase {
+	switch {
+	case g.Lobby:
+		return PhaseLobby
+	case g.Complete:
+		return PhaseComplete
+	default:
+		return PhasePlaying
+	}
+}
+
+// Join seats a player in the first free seat and returns its index. the seat gets a new token.
+func (g *Game) Join(name string) (int, RulesViolation) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if !g.Lobby {
+		return 0, errGameStarted
+	}
+	if len(name) > MAX_NAME_LENGTH {
+		return 0, violation(ViolationNameTooLong, "name is longer than %d characters", MAX_NAME_LENGTH)
+	}
+	for _, p := range g.Players {
+		if !p.Seated {
+			p.Seated = true
+			p.Ready = false
+			p.Name = name
+			if p.Name == "" {
+				p.Name = "Player" + strconv.Itoa(p.Index+1)
+			}
+			g.newSeatToken(p.Index)
+			g.UpdatedAt = time.Now()
+			return p.Index, nil
+		}
+	}
+	return 0, errGameFull
+}
+
+// TakeSeat seats a player in the given seat. the seat gets a new token.
+func (g *Game) TakeSeat(playerIndex int, name string) RulesViolation {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if !g.Lobby {
+		return errGameStarted
+	}
+	if len(name) > MAX_NAME_LENGTH {
+		return violation(ViolationNameTooLong, "name is longer than %d characters", MAX_NAME_LENGTH)
+	}
+	p := g.Players[playerIndex]
+	if p.Seated {
+		return errSeatTaken
+	}
+	p.Seated = true
+	p.Ready = false
+	if name != "" {
+		p.Name = name
+	}
+	g.newSeatToken(playerIndex)
+	g.UpdatedAt = time.Now()
+	return nil
+}
+
+// Leave frees a seat in the lobby, revoking its token
+func (g *Game) Leave(playerIndex int) RulesViolation {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if !g.Lobby {
+		return errGameStarted
+	}
+	p := g.Players[playerIndex]
+	if !p.Seated {
+		return errSeatEmpty
+	}
+	p.Seated = false
+	p.Ready = false
+	p.Name = "Player" + strconv.Itoa(p.Index+1)
+	g.revokeSeatToken(playerIndex)
+	g.UpdatedAt = time.Now()
+	return nil
+}
+
+// SetReady marks a seated player as ready or not. the game starts as soon as every seat
+// is filled and ready; SetReady returns true if this call started it.
+func (g *Game) SetReady(playerIndex int, ready bool) (bool, RulesViolation) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if !g.Lobby {
+		return false, errGameStarted
+	}
+	p := g.Players[playerIndex]
+	if !p.Seated {
+		return false, errSeatEmpty
+	}
+	p.Ready = ready
+	g.UpdatedAt = time.Now()
+
+	for _, p := range g.Players {
+		if !p.Seated || !p.Ready {
+			return false, nil
+		}
+	}
+	g.Lobby = false
+	g.startTurn()
+	g.publishStart()
+	return true, nil
+}
+
+func (s *ScoutServer) ListOpenGames(ctx context.Context, req *pb.ListOpenGamesRequest) (*pb.ListOpenGamesResponse, error) {
+	games := s.store.List()
+
+	resp := &pb.ListOpenGamesResponse{}
+	for _, game := range games {
+		if game.Phase() == PhaseLobby {
+			resp.Games = append(resp.Games, game.ToProto())
+		}
+	}
+	return resp, nil
+}
+
+func (s *ScoutServer) JoinGame(ctx context.Context, req *pb.JoinGameRequest) (*pb.JoinGameResponse, error) {
+	game := s.store.Get(req.GameId)
+
+	if game == nil {
+		return nil, errInvalidGameId
+	}
+
+	index, err := game.Join(req.Name)
+	if err != nil {
+		return nil, violationStatus(err)
+	}
+	s.giveSeat(ctx, game, index)
+	s.gameChanged(game)
+	return &pb.JoinGameResponse{PlayerIndex: int32(index), SeatToken: game.SeatToken(index)}, nil
+}
+
+func (s *ScoutServer) LeaveGame(ctx context.Context, req *pb.LeaveGameRequest) (*pb.LeaveGameResponse, error) {
+	game := s.store.Get(req.GameId)
+
+	if game == nil {
+		return nil, errInvalidGameId
+	}
+	if int(req.PlayerIndex) < 0 || int(req.PlayerIndex) >= len(game.Players) {
+		return nil, errInvalidPlayerIndex
+	}
+	if err := s.authorizeSeat(ctx, game, int(req.PlayerIndex)); err != nil {
+		return nil, err
+	}
+
+	if err := game.Leave(int(req.PlayerIndex)); err != nil {
+		return nil, violationStatus(err)
+	}
+	s.gameChanged(game)
+	return &pb.LeaveGameResponse{}, nil
+}
+
+func (s *ScoutServer) SetReady(ctx context.Context, req *pb.SetReadyRequest) (*pb.SetReadyResponse, error) {
+	game := s.store.Get(req.GameId)
+
+	if game == nil {
+		return nil, errInvalidGameId
+	}
+	if int(req.PlayerIndex) < 0 || int(req.PlayerIndex) >= len(game.Players) {
+		return nil, errInvalidPlayerIndex
+	}
+	if err := s.authorizeSeat(ctx, game, int(req.PlayerIndex)); err != nil {
+		return nil, err
+	}
+
+	started, err := game.SetReady(int(req.PlayerIndex), req.Ready)
+	if err != nil {
+		return nil, violationStatus(err)
+	}
+	s.gameChanged(game)
+	return &pb.SetReadyResponse{Started: started}, nil
+}
@@ -0,0 +1,80 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "scout-go/proto"
+)
+
+func TestLobby(t *testing.T) {
+	s := NewScoutServer()
+	ctx := context.Background()
+
+	created, err := s.CreateGame(ctx, &pb.CreateGameRequest{NumPlayers: 3, Open: true})
+	if err != nil {
+		t.Fatalf("CreateGame returned err: %v", err)
+	}
+	open, _ := s.ListOpenGames(ctx, &pb.ListOpenGamesRequest{})
+	if len(open.Games) != 1 || open.Games[0].Id != created.GameId {
+		t.Fatalf("expected the game to be open, got %v", open.Games)
+	}
+
+	tokens := make([]string, 3)
+	for i, name := range []string{"alice", "bob", "carol"} {
+		joined, err := s.JoinGame(ctx, &pb.JoinGameRequest{GameId: created.GameId, Name: name})
+		if err != nil {
+			t.Fatalf("JoinGame returned err: %v", err)
+		}
+		if joined.PlayerIndex != int32(i) {
+			t.Fatalf("expected seat %d, got %d", i, joined.PlayerIndex)
+		}
+		tokens[i] = joined.SeatToken
+	}
+
+	// nobody can play before the game starts
+	resp, _ := s.PlayerAction(asSeat(tokens[0]), &pb.PlayerActionRequest{
+		GameId: created.GameId, Action: &pb.Action{ActionType: pb.Action_ActionShow, ShowLength: 1},
+	})
+	if !resp.Err {
+		t.Fatalf("expected an action in the lobby to fail")
+	}
+	if _, err := s.JoinGame(ctx, &pb.JoinGameRequest{GameId: created.GameId, Name: "dave"}); status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("expected a full game to refuse players, got %v", err)
+	}
+
+	// bob leaves and dave takes his seat
+	if _, err := s.LeaveGame(asSeat(tokens[1]), &pb.LeaveGameRequest{GameId: created.GameId, PlayerIndex: 1}); err != nil {
+		t.Fatalf("LeaveGame returned err: %v", err)
+	}
+	joined, err := s.JoinGame(ctx, &pb.JoinGameRequest{GameId: created.GameId, Name: "dave"})
+	if err != nil || joined.PlayerIndex != 1 {
+		t.Fatalf("expected dave to take seat 1, got %v, %v", joined, err)
+	}
+	tokens[1] = joined.SeatToken
+
+	for seat := int32(0); seat < 3; seat++ {
+		ready, err := s.SetReady(asSeat(tokens[seat]), &pb.SetReadyRequest{GameId: created.GameId, PlayerIndex: seat, Ready: true})
+		if err != nil {
+			t.Fatalf("SetReady returned err: %v", err)
+		}
+		if ready.Started != (seat == 2) {
+			t.Fatalf("seat %d: expected started=%v", seat, seat == 2)
+		}
+	}
+
+	state, _ := s.GetGameState(ctx, &pb.GetGameStateRequest{GameId: created.GameId})
+	if state.Game.Phase != pb.GamePhase_PhasePlaying {
+		t.Fatalf("expected the game to be playing, got %v", state.Game.Phase)
+	}
+	if state.Game.PlayerStates[1].Name != "dave" {
+		t.Fatalf("expected seat 1 to be dave, got %s", state.Game.PlayerStates[1].Name)
+	}
+	open, _ = s.ListOpenGames(ctx, &pb.ListOpenGamesRequest{})
+	if len(open.Games) != 0 {
+		t.Fatalf("expected no open games, got %d", len(open.Games))
+	}
+}
@@ -0,0 +1,37 @@
+package server
+
+import (
+	"context"
+	"log/slog"
+)
+
+// debugLogger returns a logger for the game's state transitions, or nil if they wouldn't be
+// logged, so callers can skip building them. games configured with DebugLog log them whatever
+// the server's log level. must be called with g.mu held.
+func (g *Game) debugLogger() *slog.Logger {
+	handler := slog.Default().Handler()
+	if g.Config.DebugLog {
+		handler = debugHandler{handler}
+	}
+	if !handler.Enabled(context.Background(), slog.LevelDebug) {
+		return nil
+	}
+	return slog.New(handler).With("game_id", g.Id)
+}
+
+// debugHandler lets debug records through a handler set to a higher level
+type debugHandler struct {
+	slog.Handler
+}
+
+func (h debugHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return level >= slog.LevelDebug || h.Handler.Enabled(ctx, level)
+}
+
+func (h debugHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return debugHandler{h.Handler.WithAttrs(attrs)}
+}
+
+func (h debugHandler) WithGroup(name string) slog.Handler {
+	return debugHandler{h.Handler.WithGroup(name)}
+}
@@ -0,0 +1,57 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	pb "scout-go/proto"
+)
+
+func TestDebugLog(t *testing.T) {
+	var buf bytes.Buffer
+	defer slog.SetDefault(slog.Default())
+	slog.SetDefault(slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelInfo})))
+
+	quiet, _ := NewSeededGame(2, 1)
+	seat, _ := quiet.turn()
+	action := quiet.LegalActions(seat)[0]
+	if err := quiet.PlayerAction(seat, &action); err != nil {
+		t.Fatalf("PlayerAction returned err: %v", err)
+	}
+	if buf.Len() != 0 {
+		t.Fatalf("expected nothing logged at info level, got %s", buf.String())
+	}
+
+	game, _ := NewSeededGame(2, 1)
+	game.Configure(GameConfig{DebugLog: true})
+	if err := game.PlayerAction(seat, &action); err != nil {
+		t.Fatalf("PlayerAction returned err: %v", err)
+	}
+	logged := buf.String()
+	for _, want := range []string{"level=DEBUG", "msg=\"action applied\"", "game_id=" + game.Id, "action=" + action.Format(), "msg=\"turn passed\""} {
+		if !strings.Contains(logged, want) {
+			t.Fatalf("expected %s in\n%s", want, logged)
+		}
+	}
+}
+
+func TestDebugLogIsForAdmins(t *testing.T) {
+	s := NewScoutServer(WithAdminToken("admin"))
+	req := &pb.CreateGameRequest{NumPlayers: 2, Config: &pb.GameConfig{DebugLog: proto.Bool(true)}}
+
+	if _, err := s.CreateGame(context.Background(), req); status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("expected PermissionDenied, got %v", err)
+	}
+	admin := metadata.NewIncomingContext(context.Background(), metadata.Pairs(ADMIN_TOKEN_HEADER, "admin"))
+	if _, err := s.CreateGame(admin, req); err != nil {
+		t.Fatalf("CreateGame returned err: %v", err)
+	}
+}
@@ -2,8 +2,13 @@ package server
 
 import (
 	"encoding/json"
-	"log"
+	"log/slog"
 	pb "scout-go/proto"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 )
 
 func (g *Game) ToProto() *pb.Game {
@@ -16,10 +21,23 @@ func (g *Game) ToProto() *pb.Game {
 		ConsecutiveScouts: int32(g.ConsecutiveScouts),
 		Round:             int32(g.Round),
 		Complete:          g.Complete,
+		Phase:             pb.GamePhase(g.phase()),
+		Config:            g.Config.ToProto(),
+		CreatedAtMs:       g.CreatedAt.UnixMilli(),
+		UpdatedAtMs:       g.UpdatedAt.UnixMilli(),
 	}
 
 	if g.ActivePlayer != nil {
 		protoGame.ActivePlayerIndex = int32(g.ActivePlayer.Index)
+		_, turn := g.timeLeft(g.ActivePlayer.Index)
+		protoGame.TurnTimeRemainingMs = turn.Milliseconds()
+	}
+
+	timeouts := make([]int, len(g.Players))
+	for _, move := range g.History {
+		if move.Timeout {
+			timeouts[move.PlayerIndex]++
+		}
 	}
 
 	if g.ActiveSetPlayer != nil {
@@ -35,6 +53,22 @@ func (g *Game) ToProto() *pb.Game {
 			PlayerIndex: int32(player.Index),
 			HandSize:    int32(len(player.Hand)),
 			Score:       int32(player.Score),
+			Name:        player.Name,
+			Seated:      player.Seated,
+			Ready:       player.Ready,
+			Timeouts:    int32(timeouts[player.Index]),
+		}
+		if total, _ := g.timeLeft(player.Index); g.Config.TotalTime > 0 {
+			player_state.TimeRemainingMs = total.Milliseconds()
+		}
+		// cards scouted into a hand are known to every seat, along with where they sit
+		for i, card := range player.Hand {
+			if card.Public {
+				player_state.PublicCards = append(player_state.PublicCards, &pb.PublicCard{
+					Position: int32(i),
+					Card:     card.ToProto(),
+				})
+			}
 		}
 		protoGame.PlayerStates = append(protoGame.PlayerStates, player_state)
 	}
@@ -45,7 +79,7 @@ func (g *Game) ToProto() *pb.Game {
 func (g *Game) ToJSON() string {
 	jg, err := json.MarshalIndent(g, "", "  ")
 	if err != nil {
-		log.Printf("Error marshalling game to JSON: %v", err)
+		slog.Error("failed to marshal game to JSON", "game_id", g.Id, "err", err)
 		return ""
 	}
 	return string(jg)
@@ -74,6 +108,28 @@ func (c *Card) ToProto() *pb.Card {
 	}
 }
 
+func (c GameConfig) ToProto() *pb.GameConfig {
+	return &pb.GameConfig{
+		SpectatorVisibility: pb.Visibility(c.SpectatorVisibility).Enum(),
+		SpectatorDelay:      proto.Int32(int32(c.SpectatorDelay)),
+		TurnTimeMs:          proto.Int64(c.TurnTime.Milliseconds()),
+		TotalTimeMs:         proto.Int64(c.TotalTime.Milliseconds()),
+		TimeoutPolicy:       proto.String(c.TimeoutPolicy),
+		DebugLog:            proto.Bool(c.DebugLog),
+	}
+}
+
+func ToGameConfig(config *pb.GameConfig) GameConfig {
+	return GameConfig{
+		SpectatorVisibility: Visibility(config.GetSpectatorVisibility()),
+		SpectatorDelay:      int(config.GetSpectatorDelay()),
+		TurnTime:            time.Duration(config.GetTurnTimeMs()) * time.Millisecond,
+		TotalTime:           time.Duration(config.GetTotalTimeMs()) * time.Millisecond,
+		TimeoutPolicy:       config.GetTimeoutPolicy(),
+		DebugLog:            config.GetDebugLog(),
+	}
+}
+
 func ToActionSpec(action *pb.Action) *ActionSpec {
 	return &ActionSpec{
 		ID:             0, // internal use only
@@ -84,3 +140,101 @@ func ToActionSpec(action *pb.Action) *ActionSpec {
 		ShowLength:     int(action.ShowLength),
 	}
 }
+
+// ObservationProto returns everything the player can see: the public game state, their
+// own hand, and the observation tensor
+func (g *Game) ObservationProto(playerIndex int) *pb.Observation {
+	obs := &pb.Observation{
+		Game:   g.ToProto(),
+		Tensor: g.Observation(playerIndex),
+	}
+
+	g.mu.RLock()
+	obs.Player = g.Players[playerIndex].ToProto()
+	g.mu.RUnlock()
+
+	return obs
+}
+
+func (a *ActionSpec) ToProto() *pb.Action {
+	return &pb.Action{
+		Id:             int32(a.ID),
+		ActionType:     pb.Action_ActionType(a.Type),
+		ScoutTakeIndex: int32(a.ScoutTakeIndex),
+		ScoutPutIndex:  int32(a.ScoutPutIndex),
+		ShowFirstIndex: int32(a.ShowFirstIndex),
+		ShowLength:     int32(a.ShowLength),
+	}
+}
+
+// ToProto returns the event as seen from the given seat: the public game state, plus
+// the seat's own hand. spectators only get the public state.
+func (e *Event) ToProto(playerIndex int, spectator bool) *pb.GameEvent {
+	event := &pb.GameEvent{
+		EventType:   pb.GameEvent_EventType(e.Type),
+		Sequence:    e.Sequence,
+		PlayerIndex: int32(e.PlayerIndex),
+		ScoreDelta:  int32(e.ScoreDelta),
+		Score:       int32(e.Score),
+		Round:       int32(e.Round),
+		Game:        e.State.ToProto(),
+		Timeout:     e.Timeout,
+	}
+
+	if e.Type == EventActionApplied {
+		event.Action = e.Action.ToProto()
+		if e.Scouted != nil {
+			event.ScoutedCard = e.Scouted.ToProto()
+		}
+		for _, card := range e.Shown {
+			event.ShownCards = append(event.ShownCards, card.ToProto())
+		}
+	}
+
+	if !spectator {
+		event.Player = e.State.Players[playerIndex].ToProto()
+	}
+
+	return event
+}
+
+// FullProto returns the event with every seat's hand, for spectators allowed to see them
+func (e *Event) FullProto() *pb.GameEvent {
+	event := e.ToProto(0, true)
+	for _, player := range e.State.Players {
+		event.Players = append(event.Players, player.ToProto())
+	}
+	return event
+}
+
+// ToActionResponse reports an action's result; err is the RulesViolation it broke, if any
+func ToActionResponse(err error) *pb.PlayerActionResponse {
+	if err == nil {
+		return &pb.PlayerActionResponse{}
+	}
+	return &pb.PlayerActionResponse{
+		Err:           true,
+		ErrMsg:        err.Error(),
+		ViolationCode: pb.ViolationCode(ViolationCodeOf(err)),
+	}
+}
+
+// violationStatus turns a RulesViolation into a gRPC status, with the violation in its details.
+// broken preconditions of the game or seat are FailedPrecondition; bad moves are InvalidArgument.
+func violationStatus(err error) error {
+	code := ViolationCodeOf(err)
+	grpcCode := codes.InvalidArgument
+	switch code {
+	case ViolationUnknown, ViolationNotYourTurn, ViolationGameComplete, ViolationGameNotStarted, ViolationGameStarted,
+		ViolationNothingToScout, ViolationScoutAndShowUsed, ViolationReverseHandNotAllowed,
+		ViolationGameFull, ViolationSeatTaken, ViolationSeatEmpty:
+		grpcCode = codes.FailedPrecondition
+	}
+
+	message := violationMessage(err)
+	st := status.New(grpcCode, message)
+	if detailed, detailErr := st.WithDetails(&pb.RuleViolation{Code: pb.ViolationCode(code), Message: message}); detailErr == nil {
+		st = detailed
+	}
+	return st.Err()
+}
@@ -0,0 +1,201 @@
+package server
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+)
+
+var (
+	RPC_BUCKETS  = []float64{.001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10}
+	MASK_BUCKETS = []float64{.0001, .00025, .0005, .001, .0025, .005, .01, .025, .05, .1}
+)
+
+// Metrics counts what the server does, and serves it over HTTP in the Prometheus text format.
+// a nil *Metrics records nothing.
+type Metrics struct {
+	mu          sync.Mutex
+	rpcs        map[[2]string]*histogram // by method and status code
+	actions     []uint64                 // by ActionType
+	mask        *histogram
+	storeErrors map[string]uint64 // by store operation
+	games       func() []*Game    // see WithMetrics
+}
+
+func NewMetrics() *Metrics {
+	return &Metrics{
+		rpcs:        make(map[[2]string]*histogram),
+		actions:     make([]uint64, len(actionTypeNames)),
+		mask:        newHistogram(MASK_BUCKETS),
+		storeErrors: make(map[string]uint64),
+	}
+}
+
+// WithMetrics records the server's games, actions and store errors in m
+func WithMetrics(m *Metrics) Option {
+	return func(s *ScoutServer) {
+		s.metrics = m
+		m.games = func() []*Game { return s.store.List() }
+	}
+}
+
+// ObserveRPC records a finished call to a gRPC method
+func (m *Metrics) ObserveRPC(method, code string, duration time.Duration) {
+	if m == nil {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	key := [2]string{method, code}
+	h := m.rpcs[key]
+	if h == nil {
+		h = newHistogram(RPC_BUCKETS)
+		m.rpcs[key] = h
+	}
+	h.observe(duration.Seconds())
+}
+
+func (m *Metrics) observeAction(action ActionType) {
+	if m == nil {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if int(action) >= 0 && int(action) < len(m.actions) {
+		m.actions[action]++
+	}
+}
+
+func (m *Metrics) observeMask(duration time.Duration) {
+	if m == nil {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.mask.observe(duration.Seconds())
+}
+
+func (m *Metrics) storeError(op string) {
+	if m == nil {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.storeErrors[op]++
+}
+
+func (m *Metrics) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	m.Write(w)
+}
+
+// Write writes every metric in the Prometheus text exposition format
+func (m *Metrics) Write(w io.Writer) {
+	// count the games before taking m.mu; listing them takes the store's lock
+	phases := make([]int, 3)
+	if m.games != nil {
+		for _, game := range m.games() {
+			phases[game.Phase()]++
+		}
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	header(w, "scout_rpc_requests_total", "counter", "gRPC calls handled, by method and status code.")
+	keys := make([][2]string, 0, len(m.rpcs))
+	for key := range m.rpcs {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i][0] < keys[j][0] || (keys[i][0] == keys[j][0] && keys[i][1] < keys[j][1])
+	})
+	for _, key := range keys {
+		fmt.Fprintf(w, "scout_rpc_requests_total{%s} %d\n", rpcLabels(key), m.rpcs[key].count)
+	}
+	header(w, "scout_rpc_duration_seconds", "histogram", "gRPC call latency, by method and status code.")
+	for _, key := range keys {
+		m.rpcs[key].write(w, "scout_rpc_duration_seconds", rpcLabels(key))
+	}
+
+	header(w, "scout_games", "gauge", "Games on the server, by phase.")
+	for phase, name := range []string{"lobby", "playing", "complete"} {
+		fmt.Fprintf(w, "scout_games{phase=%q} %d\n", name, phases[phase])
+	}
+
+	header(w, "scout_actions_total", "counter", "Actions applied, by type.")
+	for i, name := range actionTypeNames {
+		fmt.Fprintf(w, "scout_actions_total{type=%q} %d\n", name, m.actions[i])
+	}
+
+	header(w, "scout_action_mask_duration_seconds", "histogram", "Time taken to build an action mask.")
+	m.mask.write(w, "scout_action_mask_duration_seconds", "")
+
+	header(w, "scout_store_errors_total", "counter", "Game store operations that failed, by operation.")
+	ops := make([]string, 0, len(m.storeErrors))
+	for op := range m.storeErrors {
+		ops = append(ops, op)
+	}
+	slices.Sort(ops)
+	for _, op := range ops {
+		fmt.Fprintf(w, "scout_store_errors_total{op=%q} %d\n", op, m.storeErrors[op])
+	}
+}
+
+func header(w io.Writer, name, kind, help string) {
+	fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s %s\n", name, help, name, kind)
+}
+
+func rpcLabels(key [2]string) string {
+	return fmt.Sprintf("method=%q,code=%q", key[0], key[1])
+}
+
+// histogram counts observations into cumulative buckets, as Prometheus expects
+type histogram struct {
+	bounds []float64
+	counts []uint64 // per bucket, not cumulative; the last is +Inf
+	sum    float64
+	count  uint64
+}
+
+func newHistogram(bounds []float64) *histogram {
+	return &histogram{bounds: bounds, counts: make([]uint64, len(bounds)+1)}
+}
+
+func (h *histogram) observe(v float64) {
+	i := sort.SearchFloat64s(h.bounds, v)
+	h.counts[i]++
+	h.sum += v
+	h.count++
+}
+
+func (h *histogram) write(w io.Writer, name, labels string) {
+	prefix := ""
+	if labels != "" {
+		prefix = labels + ","
+	}
+	cumulative := uint64(0)
+	for i, bound := range h.bounds {
+		cumulative += h.counts[i]
+		fmt.Fprintf(w, "%s_bucket{%sle=%q} %d\n", name, prefix, formatBound(bound), cumulative)
+	}
+	fmt.Fprintf(w, "%s_bucket{%sle=\"+Inf\"} %d\n", name, prefix, h.count)
+	braces := ""
+	if labels != "" {
+		braces = "{" + labels + "}"
+	}
+	fmt.Fprintf(w, "%s_sum%s %g\n%s_count%s %d\n", name, braces, h.sum, name, braces, h.count)
+}
+
+func formatBound(bound float64) string {
+	return strconv.FormatFloat(bound, 'f', -1, 64)
+}
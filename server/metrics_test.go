@@ -0,0 +1,56 @@
+package server
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	pb "scout-go/proto"
+)
+
+func TestMetrics(t *testing.T) {
+	m := NewMetrics()
+	s := NewScoutServer(WithMetrics(m))
+	ctx := context.Background()
+
+	created, _ := s.CreateGame(ctx, &pb.CreateGameRequest{NumPlayers: 2})
+	s.CreateGame(ctx, &pb.CreateGameRequest{NumPlayers: 2, Open: true})
+	s.GetValidActions(asSeat(created.SeatTokens[0]), &pb.GetValidActionsRequest{GameId: created.GameId})
+	resp, err := s.PlayerAction(asSeat(created.SeatTokens[0]), &pb.PlayerActionRequest{
+		GameId: created.GameId, Action: &pb.Action{ActionType: pb.Action_ActionShow, ShowLength: 1},
+	})
+	if err != nil || resp.Err {
+		t.Fatalf("PlayerAction failed: %v %s", err, resp.GetErrMsg())
+	}
+	m.ObserveRPC("/scout.ScoutService/CreateGame", "OK", 3*time.Millisecond)
+	m.ObserveRPC("/scout.ScoutService/CreateGame", "OK", 2*time.Second)
+
+	var out strings.Builder
+	m.Write(&out)
+	for _, line := range []string{
+		"# TYPE scout_rpc_duration_seconds histogram",
+		`scout_rpc_requests_total{method="/scout.ScoutService/CreateGame",code="OK"} 2`,
+		`scout_rpc_duration_seconds_bucket{method="/scout.ScoutService/CreateGame",code="OK",le="0.0025"} 0`,
+		`scout_rpc_duration_seconds_bucket{method="/scout.ScoutService/CreateGame",code="OK",le="0.005"} 1`,
+		`scout_rpc_duration_seconds_bucket{method="/scout.ScoutService/CreateGame",code="OK",le="+Inf"} 2`,
+		`scout_rpc_duration_seconds_count{method="/scout.ScoutService/CreateGame",code="OK"} 2`,
+		`scout_games{phase="lobby"} 1`,
+		`scout_games{phase="playing"} 1`,
+		`scout_games{phase="complete"} 0`,
+		`scout_actions_total{type="show"} 1`,
+		`scout_actions_total{type="scout"} 0`,
+		`scout_action_mask_duration_seconds_count 1`,
+	} {
+		if !strings.Contains(out.String(), line+"\n") {
+			t.Fatalf("expected %q in\n%s", line, out.String())
+		}
+	}
+}
+
+func TestNilMetrics(t *testing.T) {
+	var m *Metrics
+	m.ObserveRPC("method", "OK", time.Second)
+	m.observeAction(ActionShow)
+	m.storeError("save")
+}
@@ -0,0 +1,403 @@
+package server
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "scout-go/proto"
+)
+
+// Scout notation. cards are written top value first, e.g. 3/7. moves are:
+//
+//	S0>4       scout the active set's card 0 into hand slot 4
+//	S2r>4      the same, reversed, from card 2
+//	H2:5       show hand[2:5]
+//	S0>4+H2:5  scout, then show
+//	F          flip (reverse) the hand
+//
+// a game record is a list of headers, then one numbered move per line:
+//
+//	[Seed "42"]
+//	[Players "3"]
+//	[Rules "turn_time=30s total_time=0s timeout_policy=greedy"]
+//
+//	1. p0 H0:2
+//	2. p1 S1r>3 timeout
+//
+// games started from a position get a Position header; see FormatPosition.
+// lines starting with ; are comments.
+
+var (
+	scoutPattern  = regexp.MustCompile(`^S(\d+)(r?)>(\d+)$`)
+	showPattern   = regexp.MustCompile(`^H(\d+):(\d+)$`)
+	headerPattern = regexp.MustCompile(`^\[(\w+) "(.*)"\]$`)
+)
+
+// Format writes the card as top/bottom, e.g. 3/7
+func (c *Card) Format() string {
+	return fmt.Sprintf("%d/%d", c.Value1, c.Value2)
+}
+
+func ParseCard(s string) (*Card, error) {
+	top, bottom, ok := strings.Cut(s, "/")
+	if !ok {
+		return nil, fmt.Errorf("invalid card %q", s)
+	}
+	value1, err1 := strconv.Atoi(top)
+	value2, err2 := strconv.Atoi(bottom)
+	if err1 != nil || err2 != nil || value1 < 1 || value1 > 10 || value2 < 1 || value2 > 10 {
+		return nil, fmt.Errorf("invalid card %q", s)
+	}
+	return &Card{Value1: value1, Value2: value2}, nil
+}
+
+// Format writes the action's move code, e.g. S2r>4
+func (a ActionSpec) Format() string {
+	scout := func(reverse bool) string {
+		r := ""
+		if reverse {
+			r = "r"
+		}
+		return fmt.Sprintf("S%d%s>%d", a.ScoutTakeIndex, r, a.ScoutPutIndex)
+	}
+	show := fmt.Sprintf("H%d:%d", a.ShowFirstIndex, a.ShowFirstIndex+a.ShowLength)
+
+	switch a.Type {
+	case ActionScout, ActionScoutReverse:
+		return scout(a.Type == ActionScoutReverse)
+	case ActionShow:
+		return show
+	case ActionScoutAndShow, ActionScoutAndShowReverse:
+		return scout(a.Type == ActionScoutAndShowReverse) + "+" + show
+	case ActionReverseHand:
+		return "F"
+	}
+	return fmt.Sprintf("?%d", a.Type)
+}
+
+// ParseAction reads a move code. the action comes back with its ID in the action space.
+func ParseAction(s string) (ActionSpec, error) {
+	var action ActionSpec
+	scoutPart, showPart, both := strings.Cut(s, "+")
+
+	switch {
+	case s == "F":
+		action.Type = ActionReverseHand
+	case both || scoutPattern.MatchString(s):
+		m := scoutPattern.FindStringSubmatch(scoutPart)
+		if m == nil {
+			return action, fmt.Errorf("invalid move %q", s)
+		}
+		action.ScoutTakeIndex, _ = strconv.Atoi(m[1])
+		action.ScoutPutIndex, _ = strconv.Atoi(m[3])
+		action.Type = ActionScout
+		if m[2] == "r" {
+			action.Type = ActionScoutReverse
+		}
+		if both {
+			if !parseShow(showPart, &action) {
+				return action, fmt.Errorf("invalid move %q", s)
+			}
+			// ActionScout becomes ActionScoutAndShow, ActionScoutReverse ActionScoutAndShowReverse
+			action.Type += ActionScoutAndShow - ActionScout
+		}
+	default:
+		if !parseShow(s, &action) {
+			return action, fmt.Errorf("invalid move %q", s)
+		}
+		action.Type = ActionShow
+	}
+
+	for _, a := range AllActions() {
+		if a.Type == action.Type && a.ScoutTakeIndex == action.ScoutTakeIndex && a.ScoutPutIndex == action.ScoutPutIndex &&
+			a.ShowFirstIndex == action.ShowFirstIndex && a.ShowLength == action.ShowLength {
+			return a, nil
+		}
+	}
+	return action, fmt.Errorf("move %q is out of range", s)
+}
+
+func parseShow(s string, action *ActionSpec) bool {
+	m := showPattern.FindStringSubmatch(s)
+	if m == nil {
+		return false
+	}
+	first, _ := strconv.Atoi(m[1])
+	end, _ := strconv.Atoi(m[2])
+	action.ShowFirstIndex, action.ShowLength = first, end-first
+	return end > first
+}
+
+// FormatPosition writes a position as space separated fields, e.g.
+//
+//	active=0 round=0 scouts=0 set=2/1 owner=1 seat=3/4,8/9:0:RS seat=5/6,7/10:2:S
+//
+// each seat is its hand, score and flags: R if it can reverse its hand, S if it can scout
+// and show. an empty hand, set or flags is written as -.
+func FormatPosition(state GameState) string {
+	fields := []string{
+		fmt.Sprintf("active=%d", state.ActivePlayer),
+		fmt.Sprintf("round=%d", state.Round),
+		fmt.Sprintf("scouts=%d", state.ConsecutiveScouts),
+		"set=" + formatCards(state.ActiveSet),
+	}
+	if len(state.ActiveSet) > 0 {
+		fields = append(fields, fmt.Sprintf("owner=%d", state.ActiveSetPlayer))
+	}
+	for _, seat := range state.Seats {
+		flags := ""
+		if seat.CanReverseHand {
+			flags += "R"
+		}
+		if seat.CanScoutAndShow {
+			flags += "S"
+		}
+		if flags == "" {
+			flags = "-"
+		}
+		fields = append(fields, fmt.Sprintf("seat=%s:%d:%s", formatCards(seat.Hand), seat.Score, flags))
+	}
+	return strings.Join(fields, " ")
+}
+
+func ParsePosition(s string) (GameState, error) {
+	var state GameState
+	for _, field := range strings.Fields(s) {
+		key, value, ok := strings.Cut(field, "=")
+		if !ok {
+			return state, fmt.Errorf("invalid field %q", field)
+		}
+		var err error
+		switch key {
+		case "active":
+			state.ActivePlayer, err = strconv.Atoi(value)
+		case "round":
+			state.Round, err = strconv.Atoi(value)
+		case "scouts":
+			state.ConsecutiveScouts, err = strconv.Atoi(value)
+		case "owner":
+			state.ActiveSetPlayer, err = strconv.Atoi(value)
+		case "set":
+			state.ActiveSet, err = parseCards(value)
+		case "seat":
+			parts := strings.Split(value, ":")
+			if len(parts) != 3 {
+				return state, fmt.Errorf("invalid seat %q", value)
+			}
+			var seat SeatState
+			if seat.Hand, err = parseCards(parts[0]); err != nil {
+				return state, err
+			}
+			if seat.Score, err = strconv.Atoi(parts[1]); err != nil {
+				return state, fmt.Errorf("invalid score %q", parts[1])
+			}
+			seat.CanReverseHand = strings.Contains(parts[2], "R")
+			seat.CanScoutAndShow = strings.Contains(parts[2], "S")
+			state.Seats = append(state.Seats, seat)
+		default:
+			return state, fmt.Errorf("unknown field %q", key)
+		}
+		if err != nil {
+			return state, fmt.Errorf("invalid %s %q", key, value)
+		}
+	}
+	return state, nil
+}
+
+func formatCards(cards []*Card) string {
+	if len(cards) == 0 {
+		return "-"
+	}
+	formatted := make([]string, len(cards))
+	for i, card := range cards {
+		formatted[i] = card.Format()
+	}
+	return strings.Join(formatted, ",")
+}
+
+func parseCards(s string) ([]*Card, error) {
+	cards := make([]*Card, 0)
+	if s == "-" {
+		return cards, nil
+	}
+	for _, part := range strings.Split(s, ",") {
+		card, err := ParseCard(part)
+		if err != nil {
+			return nil, err
+		}
+		cards = append(cards, card)
+	}
+	return cards, nil
+}
+
+// FormatRecord writes the game's record: enough to play it again, move for move
+func (g *Game) FormatRecord() string {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "[Seed \"%d\"]\n", g.Seed)
+	fmt.Fprintf(&b, "[Players \"%d\"]\n", g.NumPlayers)
+	fmt.Fprintf(&b, "[Rules \"turn_time=%s total_time=%s timeout_policy=%s\"]\n", g.Config.TurnTime, g.Config.TotalTime, g.Config.TimeoutPolicy)
+	if g.Start != nil {
+		fmt.Fprintf(&b, "[Position \"%s\"]\n", FormatPosition(*g.Start))
+	}
+	if g.Complete {
+		scores := make([]string, len(g.Players))
+		for i, p := range g.Players {
+			scores[i] = strconv.Itoa(p.Score)
+		}
+		fmt.Fprintf(&b, "[Result \"%s\"]\n", strings.Join(scores, " "))
+	}
+
+	b.WriteString("\n")
+	for i, move := range g.History {
+		fmt.Fprintf(&b, "%d. p%d %s", i+1, move.PlayerIndex, move.Action.Format())
+		if move.Timeout {
+			b.WriteString(" timeout")
+		}
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+// ParseRecord plays a game record through, returning the game as it stands after the
+// last move. headers it doesn't know are ignored.
+func ParseRecord(record string) (*Game, error) {
+	headers := make(map[string]string)
+	var moves []Move
+
+	scanner := bufio.NewScanner(strings.NewReader(record))
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, ";") {
+			continue
+		}
+		if m := headerPattern.FindStringSubmatch(text); m != nil {
+			headers[m[1]] = m[2]
+			continue
+		}
+
+		fields := strings.Fields(text)
+		if len(fields) < 3 || len(fields) > 4 || fields[0] != fmt.Sprintf("%d.", len(moves)+1) ||
+			!strings.HasPrefix(fields[1], "p") || (len(fields) == 4 && fields[3] != "timeout") {
+			return nil, fmt.Errorf("line %d: invalid move %q", line, text)
+		}
+		seat, err := strconv.Atoi(fields[1][1:])
+		if err != nil {
+			return nil, fmt.Errorf("line %d: invalid seat %q", line, fields[1])
+		}
+		action, err := ParseAction(fields[2])
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %v", line, err)
+		}
+		moves = append(moves, Move{PlayerIndex: seat, Action: action, Timeout: len(fields) == 4})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	seed, err := strconv.ParseInt(headers["Seed"], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid Seed header %q", headers["Seed"])
+	}
+	config, err := parseRules(headers["Rules"])
+	if err != nil {
+		return nil, err
+	}
+
+	var g *Game
+	if position, ok := headers["Position"]; ok {
+		state, err := ParsePosition(position)
+		if err != nil {
+			return nil, fmt.Errorf("invalid Position header: %v", err)
+		}
+		g, err = NewGameFromState(state, seed)
+		if err != nil {
+			return nil, fmt.Errorf("invalid Position header: %v", err)
+		}
+	} else {
+		numPlayers, err := strconv.Atoi(headers["Players"])
+		if err != nil {
+			return nil, fmt.Errorf("invalid Players header %q", headers["Players"])
+		}
+		if g, err = NewSeededGame(numPlayers, seed); err != nil {
+			return nil, err
+		}
+	}
+	if err := g.Configure(config); err != nil {
+		return nil, err
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	for i, move := range moves {
+		if err := g.playerAction(move.PlayerIndex, &move.Action, move.Timeout); err != nil {
+			return nil, fmt.Errorf("move %d: %v", i+1, err)
+		}
+	}
+	return g, nil
+}
+
+func parseRules(rules string) (GameConfig, error) {
+	var config GameConfig
+	for _, field := range strings.Fields(rules) {
+		key, value, _ := strings.Cut(field, "=")
+		var err error
+		switch key {
+		case "turn_time":
+			config.TurnTime, err = time.ParseDuration(value)
+		case "total_time":
+			config.TotalTime, err = time.ParseDuration(value)
+		case "timeout_policy":
+			config.TimeoutPolicy = value
+		default:
+			err = fmt.Errorf("unknown rule")
+		}
+		if err != nil {
+			return config, fmt.Errorf("invalid rule %q", field)
+		}
+	}
+	return config, nil
+}
+
+// ExportGameRecord returns the game's record. the record deals every hand, so only admins
+// can have it before the game is complete.
+func (s *ScoutServer) ExportGameRecord(ctx context.Context, req *pb.ExportGameRecordRequest) (*pb.ExportGameRecordResponse, error) {
+	game := s.store.Get(req.GameId)
+	if game == nil {
+		return nil, errInvalidGameId
+	}
+	if game.Phase() != PhaseComplete && !s.isAdmin(ctx) {
+		return nil, status.Error(codes.PermissionDenied, "only admins can export unfinished games")
+	}
+
+	return &pb.ExportGameRecordResponse{Record: game.FormatRecord()}, nil
+}
+
+// ImportGameRecord plays a record through as a new game
+func (s *ScoutServer) ImportGameRecord(ctx context.Context, req *pb.ImportGameRecordRequest) (*pb.ImportGameRecordResponse, error) {
+	if !s.isAdmin(ctx) {
+		return nil, status.Error(codes.PermissionDenied, "only admins can import games")
+	}
+
+	game, err := ParseRecord(req.Record)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := s.addGame(game); err != nil {
+		return nil, err
+	}
+	s.gameChanged(game)
+
+	return &pb.ImportGameRecordResponse{GameId: game.Id, SeatTokens: game.seatTokens()}, nil
+}
@@ -0,0 +1,170 @@
+package server
+
+import (
+	"context"
+	"math/rand/v2"
+	"reflect"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	pb "scout-go/proto"
+)
+
+func TestActionNotation(t *testing.T) {
+	tests := []struct {
+		code   string
+		action ActionSpec
+	}{
+		{"S0>4", ActionSpec{Type: ActionScout, ScoutTakeIndex: 0, ScoutPutIndex: 4}},
+		{"S2r>4", ActionSpec{Type: ActionScoutReverse, ScoutTakeIndex: 2, ScoutPutIndex: 4}},
+		{"H2:5", ActionSpec{Type: ActionShow, ShowFirstIndex: 2, ShowLength: 3}},
+		{"S0>4+H2:5", ActionSpec{Type: ActionScoutAndShow, ScoutPutIndex: 4, ShowFirstIndex: 2, ShowLength: 3}},
+		{"S1r>0+H0:1", ActionSpec{Type: ActionScoutAndShowReverse, ScoutTakeIndex: 1, ShowLength: 1}},
+		{"F", ActionSpec{Type: ActionReverseHand}},
+	}
+
+	for _, test := range tests {
+		action, err := ParseAction(test.code)
+		if err != nil {
+			t.Fatalf("%s: ParseAction returned err: %v", test.code, err)
+		}
+		if !reflect.DeepEqual(action, AllActions()[action.ID]) {
+			t.Fatalf("%s: expected an action from the action space, got %v", test.code, action)
+		}
+		action.ID = 0
+		if action != test.action {
+			t.Fatalf("%s: expected %v, got %v", test.code, test.action, action)
+		}
+		if code := test.action.Format(); code != test.code {
+			t.Fatalf("expected %s, got %s", test.code, code)
+		}
+	}
+
+	for _, code := range []string{"", "S>4", "S0>", "H2:2", "H5:2", "S0>4+", "S0>4+F", "F+H0:1", "S99>0", "H0:99", "s0>4"} {
+		if _, err := ParseAction(code); err == nil {
+			t.Fatalf("%q: expected an error", code)
+		}
+	}
+}
+
+func TestPositionNotation(t *testing.T) {
+	position := "active=1 round=2 scouts=1 set=2/1,3/2 owner=0 seat=3/4,8/9:0:RS seat=-:-2:- seat=5/6:4:S"
+	state, err := ParsePosition(position)
+	if err != nil {
+		t.Fatalf("ParsePosition returned err: %v", err)
+	}
+	if state.ActivePlayer != 1 || state.Round != 2 || state.ConsecutiveScouts != 1 || len(state.Seats) != 3 {
+		t.Fatalf("unexpected position %+v", state)
+	}
+	if s := state.Seats[2]; s.Score != 4 || s.CanReverseHand || !s.CanScoutAndShow || s.Hand[0].Value1 != 5 {
+		t.Fatalf("unexpected seat %+v", s)
+	}
+	if formatted := FormatPosition(state); formatted != position {
+		t.Fatalf("expected %s, got %s", position, formatted)
+	}
+
+	for _, bad := range []string{"active", "colour=1", "set=11/2", "seat=3/4:0", "seat=3/4:x:RS"} {
+		if _, err := ParsePosition(bad); err == nil {
+			t.Fatalf("%q: expected an error", bad)
+		}
+	}
+}
+
+func TestGameRecord(t *testing.T) {
+	game, _ := NewSeededGame(2, 11)
+	game.Configure(GameConfig{TimeoutPolicy: "random"})
+	r := rand.New(rand.NewPCG(5, 6))
+	for game.Phase() == PhasePlaying && len(game.History) < 200 {
+		seat, _ := game.turn()
+		action := RandomPolicy{}.ChooseAction(game, seat, game.LegalActions(seat), r)
+		game.PlayerAction(seat, &action)
+	}
+
+	record := game.FormatRecord()
+	parsed, err := ParseRecord(record)
+	if err != nil {
+		t.Fatalf("ParseRecord returned err: %v\n%s", err, record)
+	}
+	if again := parsed.FormatRecord(); again != record {
+		t.Fatalf("expected the same record, got\n%s\nwant\n%s", again, record)
+	}
+	for i := range game.Players {
+		if !reflect.DeepEqual(parsed.Players[i].Hand, game.Players[i].Hand) || parsed.Players[i].Score != game.Players[i].Score {
+			t.Fatalf("seat %d: expected the same hand and score", i)
+		}
+	}
+	if parsed.Config.TimeoutPolicy != "random" {
+		t.Fatalf("expected the rules to be kept")
+	}
+
+	tests := []struct {
+		name   string
+		record string
+	}{
+		{"no seed", "[Players \"2\"]\n"},
+		{"bad rules", "[Seed \"1\"]\n[Players \"2\"]\n[Rules \"turn_time=soon\"]\n"},
+		{"moves out of order", "[Seed \"1\"]\n[Players \"2\"]\n\n2. p0 H0:1\n"},
+		{"illegal move", "[Seed \"1\"]\n[Players \"2\"]\n\n1. p1 H0:1\n"},
+	}
+	for _, test := range tests {
+		if _, err := ParseRecord(test.record); err == nil {
+			t.Fatalf("%s: expected an error", test.name)
+		}
+	}
+}
+
+func TestGameRecordFromPosition(t *testing.T) {
+	record := `[Seed "3"]
+[Players "2"]
+[Position "active=0 round=0 scouts=0 set=2/1 owner=1 seat=3/4:0:RS seat=5/6,7/10:0:RS"]
+; seat 0 goes out
+
+1. p0 H0:1
+`
+	game, err := ParseRecord(record)
+	if err != nil {
+		t.Fatalf("ParseRecord returned err: %v", err)
+	}
+	if game.Round != 1 || game.Players[1].Score != -2 {
+		t.Fatalf("expected the round to end, got round %d", game.Round)
+	}
+	if parsed, _ := ParseRecord(game.FormatRecord()); parsed == nil || parsed.Round != 1 {
+		t.Fatalf("expected the formatted record to parse")
+	}
+}
+
+func TestExportImportGameRecord(t *testing.T) {
+	s := NewScoutServer(WithAdminToken("admin"))
+	ctx := context.Background()
+	admin := metadata.NewIncomingContext(ctx, metadata.Pairs(ADMIN_TOKEN_HEADER, "admin"))
+
+	created, _ := s.CreateGame(ctx, &pb.CreateGameRequest{NumPlayers: 2})
+	if _, err := s.ExportGameRecord(ctx, &pb.ExportGameRecordRequest{GameId: created.GameId}); status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("expected unfinished games to be admin only, got %v", err)
+	}
+	exported, err := s.ExportGameRecord(admin, &pb.ExportGameRecordRequest{GameId: created.GameId})
+	if err != nil {
+		t.Fatalf("ExportGameRecord returned err: %v", err)
+	}
+
+	if _, err := s.ImportGameRecord(ctx, &pb.ImportGameRecordRequest{Record: exported.Record}); status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("expected imports to be admin only, got %v", err)
+	}
+	imported, err := s.ImportGameRecord(admin, &pb.ImportGameRecordRequest{Record: exported.Record})
+	if err != nil {
+		t.Fatalf("ImportGameRecord returned err: %v", err)
+	}
+	original, copied := s.store.Get(created.GameId), s.store.Get(imported.GameId)
+	if !reflect.DeepEqual(original.Players[0].Hand, copied.Players[0].Hand) {
+		t.Fatalf("expected the same deal")
+	}
+
+	// anyone can have the record of a finished game
+	original.Complete = true
+	if _, err := s.ExportGameRecord(ctx, &pb.ExportGameRecordRequest{GameId: created.GameId}); err != nil {
+		t.Fatalf("ExportGameRecord returned err: %v", err)
+	}
+}
@@ -0,0 +1,88 @@
+package server
+
+const (
+	MAX_PLAYERS      = 5
+	MAX_CARD_VALUE   = 10
+	CARD_FEATURES    = 2 * MAX_CARD_VALUE // one-hot Value1, then one-hot Value2
+	SEAT_FEATURES    = 5 + MAX_HAND_SIZE*CARD_FEATURES
+	GLOBAL_FEATURES  = MAX_PLAYERS + 2
+	OBSERVATION_SIZE = MAX_HAND_SIZE*CARD_FEATURES + MAX_ACTIVE_SET_SIZE*CARD_FEATURES + MAX_PLAYERS*SEAT_FEATURES + GLOBAL_FEATURES
+)
+
+// Observation encodes what the player can see as a fixed-size vector, for training.
+// every section is zero-padded, and seats are ordered relative to the observer (the
+// observer first, then the player to their left, and so on). the layout is:
+//
+//	own hand     MAX_HAND_SIZE x CARD_FEATURES
+//	active set   MAX_ACTIVE_SET_SIZE x CARD_FEATURES
+//	each seat    MAX_PLAYERS x SEAT_FEATURES:
+//	               hand size / MAX_HAND_SIZE, score / 10, is active player,
+//	               played the active set, can scout and show,
+//	               public cards in hand (MAX_HAND_SIZE x CARD_FEATURES)
+//	global       num players one-hot (MAX_PLAYERS), consecutive scouts / MAX_PLAYERS,
+//	             round / MAX_PLAYERS
+//
+// cards past the end of a section are dropped.
+func (g *Game) Observation(playerIndex int) []float32 {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	obs := make([]float32, OBSERVATION_SIZE)
+	offset := 0
+
+	encodeCards(obs[offset:offset+MAX_HAND_SIZE*CARD_FEATURES], g.Players[playerIndex].Hand, false)
+	offset += MAX_HAND_SIZE * CARD_FEATURES
+
+	encodeCards(obs[offset:offset+MAX_ACTIVE_SET_SIZE*CARD_FEATURES], g.ActiveSet, false)
+	offset += MAX_ACTIVE_SET_SIZE * CARD_FEATURES
+
+	for i := 0; i < MAX_PLAYERS; i++ {
+		seat := obs[offset : offset+SEAT_FEATURES]
+		offset += SEAT_FEATURES
+		if i >= len(g.Players) {
+			continue
+		}
+		p := g.Players[(playerIndex+i)%len(g.Players)]
+		seat[0] = float32(len(p.Hand)) / MAX_HAND_SIZE
+		seat[1] = float32(p.Score) / 10
+		if g.ActivePlayer == p {
+			seat[2] = 1
+		}
+		if g.ActiveSetPlayer == p {
+			seat[3] = 1
+		}
+		if p.CanScoutAndShow {
+			seat[4] = 1
+		}
+		encodeCards(seat[5:], p.Hand, true)
+	}
+
+	global := obs[offset:]
+	if len(g.Players) <= MAX_PLAYERS {
+		global[len(g.Players)-1] = 1
+	}
+	global[MAX_PLAYERS] = float32(g.ConsecutiveScouts) / MAX_PLAYERS
+	global[MAX_PLAYERS+1] = float32(g.Round) / MAX_PLAYERS
+
+	return obs
+}
+
+// encodeCards writes the cards into dst, CARD_FEATURES per slot; when publicOnly is
+// set, only the slots holding public cards are written.
+func encodeCards(dst []float32, cards []*Card, publicOnly bool) {
+	for i, card := range cards {
+		if (i+1)*CARD_FEATURES > len(dst) {
+			return
+		}
+		if publicOnly && !card.Public {
+			continue
+		}
+		slot := dst[i*CARD_FEATURES : (i+1)*CARD_FEATURES]
+		if card.Value1 >= 1 && card.Value1 <= MAX_CARD_VALUE {
+			slot[card.Value1-1] = 1
+		}
+		if card.Value2 >= 1 && card.Value2 <= MAX_CARD_VALUE {
+			slot[MAX_CARD_VALUE+card.Value2-1] = 1
+		}
+	}
+}
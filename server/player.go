@@ -7,6 +7,8 @@ type Player struct {
 	Hand            []*Card
 	CanReverseHand  bool
 	CanScoutAndShow bool
+	Seated          bool // a player has taken the seat
+	Ready           bool // the player is ready to start (lobby games only)
 }
 
 func NewPlayer(name string, index int) (*Player, error) {
@@ -17,6 +19,7 @@ func NewPlayer(name string, index int) (*Player, error) {
 		Hand:            make([]*Card, 0),
 		CanReverseHand:  true,
 		CanScoutAndShow: true,
+		Seated:          true,
 	}, nil
 }
 
@@ -0,0 +1,139 @@
+package server
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"sort"
+)
+
+// Policy picks an action for a player from the actions that are legal for them.
+// policies hold no state of their own; any randomness comes from r.
+type Policy interface {
+	Name() string
+	ChooseAction(g *Game, playerIndex int, legal []ActionSpec, r *rand.Rand) ActionSpec
+}
+
+const MAX_ACTIONS_PER_GAME = 10000 // guards PlayOut against games that never finish
+
+var policies = map[string]Policy{
+	"random": RandomPolicy{},
+	"greedy": GreedyPolicy{},
+}
+
+// NewPolicy returns the built-in policy with the given name
+func NewPolicy(name string) (Policy, error) {
+	p, ok := policies[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown policy %q", name)
+	}
+	return p, nil
+}
+
+// PolicyNames returns the names of the built-in policies
+func PolicyNames() []string {
+	names := make([]string, 0, len(policies))
+	for name := range policies {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// PlayOut plays the game to the end with a policy in every seat. observe, if not nil, is
+// called with each action a seat chooses, before it is applied. it returns false if a seat had
+// nothing to play, the game refused an action, or the game went on for MAX_ACTIONS_PER_GAME
+// actions without finishing.
+func PlayOut(g *Game, seated []Policy, r *rand.Rand, observe func(seat int, legal []ActionSpec, action ActionSpec)) bool {
+	for step := 0; ; step++ {
+		seat, phase := g.turn()
+		if phase == PhaseComplete {
+			return true
+		}
+		if step >= MAX_ACTIONS_PER_GAME {
+			return false
+		}
+		legal := g.LegalActions(seat)
+		if len(legal) == 0 {
+			return false
+		}
+		action := seated[seat].ChooseAction(g, seat, legal, r)
+		if observe != nil {
+			observe(seat, legal, action)
+		}
+		if err := g.PlayerAction(seat, &action); err != nil {
+			return false
+		}
+	}
+}
+
+// RandomPolicy picks uniformly among the legal actions
+type RandomPolicy struct{}
+
+func (RandomPolicy) Name() string { return "random" }
+
+func (RandomPolicy) ChooseAction(g *Game, playerIndex int, legal []ActionSpec, r *rand.Rand) ActionSpec {
+	return legal[r.IntN(len(legal))]
+}
+
+// GreedyPolicy sheds as many cards as it can each turn. it shows the longest set it can,
+// only uses scout and show when that shows a longer set than showing alone, and otherwise
+// scouts a card into the slot that builds the longest set in its hand.
+type GreedyPolicy struct{}
+
+func (GreedyPolicy) Name() string { return "greedy" }
+
+func (GreedyPolicy) ChooseAction(g *Game, playerIndex int, legal []ActionSpec, r *rand.Rand) ActionSpec {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	best := make([]ActionSpec, 0)
+	bestScore := 0.0
+	for _, action := range legal {
+		var score float64
+		switch action.Type {
+		case ActionShow:
+			score = float64(action.ShowLength)
+		case ActionScoutAndShow, ActionScoutAndShowReverse:
+			// scouting gives away a point, so it has to be worth more than one card
+			score = float64(action.ShowLength) - 1.5
+		case ActionScout, ActionScoutReverse:
+			score = -1 + 0.1*float64(g.longestSetAfterScout(g.Players[playerIndex], action))
+		default:
+			continue
+		}
+		if len(best) == 0 || score > bestScore {
+			best = best[:0]
+			bestScore = score
+		}
+		if score == bestScore {
+			best = append(best, action)
+		}
+	}
+	if len(best) == 0 {
+		return legal[r.IntN(len(legal))]
+	}
+	return best[r.IntN(len(best))]
+}
+
+// longestSetAfterScout returns the length of the longest valid set in the player's hand
+// after the given scout action
+func (g *Game) longestSetAfterScout(p *Player, action ActionSpec) int {
+	card := *g.ActiveSet[action.ScoutTakeIndex]
+	if action.Type == ActionScoutReverse {
+		card.ReverseValues()
+	}
+	hand := make([]*Card, 0, len(p.Hand)+1)
+	hand = append(hand, p.Hand[:action.ScoutPutIndex]...)
+	hand = append(hand, &card)
+	hand = append(hand, p.Hand[action.ScoutPutIndex:]...)
+
+	longest := 0
+	for start := range hand {
+		for end := start + longest + 1; end <= len(hand); end++ {
+			if validateSet(hand[start:end]) == nil {
+				longest = end - start
+			}
+		}
+	}
+	return longest
+}
@@ -0,0 +1,274 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net"
+	"path"
+	"sync"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// methods that create games, limited separately from the ones that step them
+var CREATE_METHODS = map[string]bool{
+	"CreateGame":          true,
+	"CreateGameFromState": true,
+	"ImportGame":          true,
+	"ImportGameRecord":    true,
+}
+
+// RateLimits are per-client limits on calls to the server. a zero rate or cap turns that limit off.
+type RateLimits struct {
+	KeyBy        string  // "peer" keys clients by address, "identity" by their certificate or the admin token
+	CreateRate   float64 // calls per second to CREATE_METHODS
+	CreateBurst  int     // calls allowed at once; defaults to the rate rounded up
+	StepRate     float64 // calls per second to every other unary method, and messages per second on streams
+	StepBurst    int
+	MaxLiveGames int // unfinished games one client may have created
+}
+
+// RateLimiter enforces RateLimits with a token bucket per client. give it to the server with
+// WithRateLimiter, so it can tell who its callers are and which games are still going.
+type RateLimiter struct {
+	limits   RateLimits
+	now      func() time.Time
+	identify func(ctx context.Context) string // see ScoutServer.identity
+	live     func(gameId string) bool
+
+	mu      sync.Mutex
+	buckets map[bucketKey]*bucket
+	pruneAt int
+	games   map[string]map[string]bool // ids of the games each client created
+	pending map[string]int             // games each client is creating
+}
+
+type bucketKey struct {
+	client string
+	create bool
+}
+
+type bucket struct {
+	tokens float64
+	last   time.Time
+}
+
+func NewRateLimiter(limits RateLimits) (*RateLimiter, error) {
+	switch limits.KeyBy {
+	case "":
+		limits.KeyBy = "peer"
+	case "peer", "identity":
+	default:
+		return nil, fmt.Errorf("unknown rate limit key %q", limits.KeyBy)
+	}
+	if limits.CreateBurst < 1 {
+		limits.CreateBurst = max(1, int(math.Ceil(limits.CreateRate)))
+	}
+	if limits.StepBurst < 1 {
+		limits.StepBurst = max(1, int(math.Ceil(limits.StepRate)))
+	}
+	return &RateLimiter{
+		limits:  limits,
+		now:     time.Now,
+		buckets: make(map[bucketKey]*bucket),
+		pruneAt: 1024,
+		games:   make(map[string]map[string]bool),
+		pending: make(map[string]int),
+	}, nil
+}
+
+// WithRateLimiter lets l identify the server's callers and count their unfinished games
+func WithRateLimiter(l *RateLimiter) Option {
+	return func(s *ScoutServer) {
+		l.identify = s.identity
+		l.live = func(gameId string) bool {
+			game := s.store.Get(gameId)
+			return game != nil && game.Phase() != PhaseComplete
+		}
+	}
+}
+
+// UnaryInterceptor rejects calls over the client's limits with RESOURCE_EXHAUSTED
+func (l *RateLimiter) UnaryInterceptor(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	client := l.client(ctx)
+	create := CREATE_METHODS[path.Base(info.FullMethod)]
+	if wait := l.take(client, create); wait > 0 {
+		kind := "stepping games"
+		if create {
+			kind = "creating games"
+		}
+		st, _ := status.Newf(codes.ResourceExhausted, "rate limit for %s exceeded; retry in %s", kind, wait).
+			WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(wait)})
+		return nil, st.Err()
+	}
+	if !create || l.limits.MaxLiveGames <= 0 {
+		return handler(ctx, req)
+	}
+
+	if err := l.reserveGame(client); err != nil {
+		return nil, err
+	}
+	resp, err := handler(ctx, req)
+	l.releaseGame(client, resp, err)
+	return resp, err
+}
+
+// StreamInterceptor holds back each message a stream receives until the client's step limit
+// allows it. streams are keyed as unary calls are, by the caller; see client.
+func (l *RateLimiter) StreamInterceptor(
+	srv interface{},
+	ss grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	if l.limits.StepRate <= 0 {
+		return handler(srv, ss)
+	}
+	return handler(srv, &limitedStream{ServerStream: ss, limiter: l, client: l.client(ss.Context())})
+}
+
+type limitedStream struct {
+	grpc.ServerStream
+	limiter *RateLimiter
+	client  string
+}
+
+func (s *limitedStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	for {
+		wait := s.limiter.take(s.client, false)
+		if wait == 0 {
+			return nil
+		}
+		select {
+		case <-time.After(wait):
+		case <-s.Context().Done():
+			return status.FromContextError(s.Context().Err()).Err()
+		}
+	}
+}
+
+// client returns the key the caller's limits are kept under
+func (l *RateLimiter) client(ctx context.Context) string {
+	if l.limits.KeyBy == "identity" && l.identify != nil {
+		if id := l.identify(ctx); id != "" {
+			return id
+		}
+	}
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return "unknown"
+	}
+	addr := p.Addr.String()
+	if host, _, err := net.SplitHostPort(addr); err == nil {
+		return "peer:" + host
+	}
+	return "peer:" + addr
+}
+
+func (l *RateLimiter) limit(create bool) (float64, int) {
+	if create {
+		return l.limits.CreateRate, l.limits.CreateBurst
+	}
+	return l.limits.StepRate, l.limits.StepBurst
+}
+
+// take takes a token from the client's bucket, or returns how long until there will be one
+func (l *RateLimiter) take(client string, create bool) time.Duration {
+	rate, burst := l.limit(create)
+	if rate <= 0 {
+		return 0
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	key := bucketKey{client, create}
+	b := l.buckets[key]
+	if b == nil {
+		l.prune(now)
+		b = &bucket{tokens: float64(burst), last: now}
+		l.buckets[key] = b
+	}
+	b.tokens = min(float64(burst), b.tokens+now.Sub(b.last).Seconds()*rate)
+	b.last = now
+	if b.tokens < 1 {
+		// round up to the millisecond, so a retry after the wait finds a token
+		return time.Duration(math.Ceil((1-b.tokens)/rate*1000)) * time.Millisecond
+	}
+	b.tokens--
+	return 0
+}
+
+// prune forgets the buckets that have filled up again, once there are enough to matter.
+// must be called with l.mu held.
+func (l *RateLimiter) prune(now time.Time) {
+	if len(l.buckets) < l.pruneAt {
+		return
+	}
+	for key, b := range l.buckets {
+		rate, burst := l.limit(key.create)
+		if b.tokens+now.Sub(b.last).Seconds()*rate >= float64(burst) {
+			delete(l.buckets, key)
+		}
+	}
+	l.pruneAt = max(1024, 2*len(l.buckets))
+}
+
+// reserveGame holds one of the client's unfinished games for a create in flight
+func (l *RateLimiter) reserveGame(client string) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	games := l.games[client]
+	for id := range games {
+		if l.live != nil && !l.live(id) {
+			delete(games, id)
+		}
+	}
+	if len(games) == 0 {
+		delete(l.games, client)
+	}
+	if len(games)+l.pending[client] >= l.limits.MaxLiveGames {
+		st, _ := status.Newf(codes.ResourceExhausted, "too many unfinished games; finish or delete one of your %d first", l.limits.MaxLiveGames).
+			WithDetails(&errdetails.QuotaFailure{Violations: []*errdetails.QuotaFailure_Violation{{
+				Subject:     client,
+				Description: fmt.Sprintf("at most %d unfinished games per client", l.limits.MaxLiveGames),
+			}}})
+		return st.Err()
+	}
+	l.pending[client]++
+	return nil
+}
+
+// releaseGame counts the game the create made, if it made one, against the client
+func (l *RateLimiter) releaseGame(client string, resp interface{}, err error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.pending[client]--; l.pending[client] == 0 {
+		delete(l.pending, client)
+	}
+	r, ok := resp.(interface{ GetGameId() string })
+	if err != nil || !ok || r.GetGameId() == "" {
+		return
+	}
+	if l.games[client] == nil {
+		l.games[client] = make(map[string]bool)
+	}
+	l.games[client][r.GetGameId()] = true
+}
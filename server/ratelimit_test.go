@@ -0,0 +1,127 @@
+package server
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	pb "scout-go/proto"
+)
+
+func fromPeer(addr string) context.Context {
+	tcp, _ := net.ResolveTCPAddr("tcp", addr)
+	return peer.NewContext(context.Background(), &peer.Peer{Addr: tcp})
+}
+
+func TestRateLimits(t *testing.T) {
+	l, err := NewRateLimiter(RateLimits{CreateRate: 1, CreateBurst: 2, StepRate: 10})
+	if err != nil {
+		t.Fatalf("NewRateLimiter returned err: %v", err)
+	}
+	now := time.Unix(0, 0)
+	l.now = func() time.Time { return now }
+
+	ok := func(ctx context.Context, req interface{}) (interface{}, error) { return nil, nil }
+	call := func(ctx context.Context, method string) error {
+		_, err := l.UnaryInterceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: "/scout.ScoutService/" + method}, ok)
+		return err
+	}
+
+	worker := fromPeer("10.0.0.1:4000")
+	for i := 0; i < 2; i++ {
+		if err := call(worker, "CreateGame"); err != nil {
+			t.Fatalf("create %d: expected the burst to be allowed, got %v", i, err)
+		}
+	}
+	err = call(fromPeer("10.0.0.1:4001"), "CreateGame")
+	if status.Code(err) != codes.ResourceExhausted {
+		t.Fatalf("expected the same host to be limited, got %v", err)
+	}
+	var retry *errdetails.RetryInfo
+	for _, detail := range status.Convert(err).Details() {
+		retry, _ = detail.(*errdetails.RetryInfo)
+	}
+	if retry == nil || retry.RetryDelay.AsDuration() != time.Second {
+		t.Fatalf("expected a retry delay of 1s, got %v", retry)
+	}
+	if err := call(worker, "PlayerAction"); err != nil {
+		t.Fatalf("expected steps to be limited separately, got %v", err)
+	}
+	if err := call(fromPeer("10.0.0.2:4000"), "CreateGame"); err != nil {
+		t.Fatalf("expected other hosts to have their own limit, got %v", err)
+	}
+
+	now = now.Add(time.Second)
+	if err := call(worker, "CreateGame"); err != nil {
+		t.Fatalf("expected the bucket to refill, got %v", err)
+	}
+
+	if _, err := NewRateLimiter(RateLimits{KeyBy: "cookie"}); err == nil {
+		t.Fatalf("expected an unknown key to be an error")
+	}
+}
+
+func TestRateLimitLiveGames(t *testing.T) {
+	l, _ := NewRateLimiter(RateLimits{KeyBy: "identity", MaxLiveGames: 1})
+	s := NewScoutServer(WithAdminToken("admin"), WithRateLimiter(l))
+	create := func(ctx context.Context) (*pb.CreateGameResponse, error) {
+		resp, err := l.UnaryInterceptor(ctx, &pb.CreateGameRequest{NumPlayers: 2}, &grpc.UnaryServerInfo{FullMethod: "/scout.ScoutService/CreateGame"},
+			func(ctx context.Context, req interface{}) (interface{}, error) {
+				return s.CreateGame(ctx, req.(*pb.CreateGameRequest))
+			})
+		if err != nil {
+			return nil, err
+		}
+		return resp.(*pb.CreateGameResponse), nil
+	}
+
+	worker := fromPeer("10.0.0.1:4000")
+	created, err := create(worker)
+	if err != nil {
+		t.Fatalf("CreateGame returned err: %v", err)
+	}
+	if _, err := create(worker); status.Code(err) != codes.ResourceExhausted {
+		t.Fatalf("expected a second live game to be refused, got %v", err)
+	}
+	admin := metadata.NewIncomingContext(worker, metadata.Pairs(ADMIN_TOKEN_HEADER, "admin"))
+	if _, err := create(admin); err != nil {
+		t.Fatalf("expected the admin to be its own client, got %v", err)
+	}
+
+	s.store.Get(created.GameId).Complete = true
+	if _, err := create(worker); err != nil {
+		t.Fatalf("expected finished games not to count, got %v", err)
+	}
+}
+
+func TestRateLimitSeatsShareTheCallersLimit(t *testing.T) {
+	l, _ := NewRateLimiter(RateLimits{KeyBy: "identity", StepRate: 1})
+	s := NewScoutServer(WithRateLimiter(l))
+	now := time.Unix(0, 0)
+	l.now = func() time.Time { return now }
+
+	// one worker playing seats in two games is one client
+	ctx := context.Background()
+	first, _ := s.CreateGame(ctx, &pb.CreateGameRequest{NumPlayers: 2})
+	second, _ := s.CreateGame(ctx, &pb.CreateGameRequest{NumPlayers: 2})
+	call := func(gameId, token string) error {
+		ctx := metadata.NewIncomingContext(fromPeer("10.0.0.1:4000"), metadata.Pairs(SEAT_TOKEN_HEADER, token))
+		_, err := l.UnaryInterceptor(ctx, &pb.GetValidActionsRequest{GameId: gameId}, &grpc.UnaryServerInfo{FullMethod: "/scout.ScoutService/GetValidActions"},
+			func(ctx context.Context, req interface{}) (interface{}, error) { return nil, nil })
+		return err
+	}
+	if err := call(first.GameId, first.SeatTokens[0]); err != nil {
+		t.Fatalf("GetValidActions returned err: %v", err)
+	}
+	if err := call(second.GameId, second.SeatTokens[0]); status.Code(err) != codes.ResourceExhausted {
+		t.Fatalf("expected the worker's seat in another game to share its limit, got %v", err)
+	}
+}
@@ -2,69 +2,187 @@ package server
 
 import (
 	"context"
-	"fmt"
+	"log/slog"
 	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	pb "scout-go/proto"
 )
 
+const WATCH_BUFFER_SIZE = 256 // events buffered per watcher before it is dropped
+
+// what handlers return for a game or seat that doesn't exist
+var (
+	errInvalidGameId      = status.Error(codes.NotFound, "invalid game_id")
+	errInvalidPlayerIndex = status.Error(codes.InvalidArgument, "invalid player_index")
+)
+
 type ScoutServer struct {
 	pb.UnimplementedScoutServiceServer
 
 	mu         sync.RWMutex
-	Games      map[string]*Game
+	store      GameStore
 	AllActions []ActionSpec
+	agents     map[string]*gameAgents
+	sessions   map[string]map[int]bool // seats held by a PlaySession, per game
+	adminToken string
+	metrics    *Metrics
+
+	// client certificates; see WithClientIdentities
+	identities      ClientIdentities
+	adminIdentities map[string]bool
+
+	// defaults for what requests leave out; see WithDefaultGameConfig and WithAgentDefaults
+	defaultConfig GameConfig
+	agentTimeout  time.Duration
+	agentFallback string
+
+	// lifecycle; see lifecycle.go
+	completedTTL time.Duration
+	idleTTL      time.Duration
+	maxGames     int
+	addMu        sync.Mutex // held from checking maxGames until the game is added
+	done         chan struct{}
+	closeOnce    sync.Once
 }
 
-func NewScoutServer() *ScoutServer {
-	return &ScoutServer{
-		Games:      make(map[string]*Game),
+// Option configures a ScoutServer
+type Option func(*ScoutServer)
+
+func NewScoutServer(opts ...Option) *ScoutServer {
+	s := &ScoutServer{
+		store:      NewMemoryStore(),
 		AllActions: getAllActions(),
+		agents:     make(map[string]*gameAgents),
+		sessions:   make(map[string]map[int]bool),
+		done:       make(chan struct{}),
+
+		agentTimeout:  DEFAULT_AGENT_TIMEOUT,
+		agentFallback: DEFAULT_FALLBACK_POLICY,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	// games loaded from the store need to hear about their timeouts too
+	for _, game := range s.store.List() {
+		s.hookGame(game)
+	}
+	if s.completedTTL > 0 || s.idleTTL > 0 {
+		go s.reapLoop()
+	}
+	return s
+}
+
+// gameChanged is called after anything changes a game: it saves the game, and lets agents
+// take their turns
+func (s *ScoutServer) gameChanged(game *Game) {
+	s.save(game)
+	s.driveAgents(game)
+}
+
+// save hands the game's changes to the store; failures are logged, and the game plays on
+func (s *ScoutServer) save(game *Game) {
+	if err := s.store.Save(game); err != nil {
+		slog.Error("failed to save game", "game_id", game.Id, "err", err)
+		s.metrics.storeError("save")
 	}
 }
 
+// addGame hands a new game to the store, if the server has room for another live game
+func (s *ScoutServer) addGame(game *Game) error {
+	s.addMu.Lock()
+	defer s.addMu.Unlock()
+
+	if err := s.checkGameLimit(); err != nil {
+		game.Close()
+		return err
+	}
+	s.hookGame(game)
+	if err := s.store.Add(game); err != nil {
+		s.metrics.storeError("add")
+		game.Close()
+		return status.Error(codes.Internal, err.Error())
+	}
+	return nil
+}
+
+// hookGame lets the server act on the game's timeouts, agents may be up next, and count its moves
+func (s *ScoutServer) hookGame(game *Game) {
+	game.mu.Lock()
+	defer game.mu.Unlock()
+
+	game.afterTimeout = func() { s.gameChanged(game) }
+	game.onMove = func(move Move) { s.metrics.observeAction(move.Action.Type) }
+}
+
+// actionMask is game.ActionMask, timed
+func (s *ScoutServer) actionMask(game *Game, playerIndex int) []bool {
+	start := time.Now()
+	mask := game.ActionMask(playerIndex)
+	s.metrics.observeMask(time.Since(start))
+	return mask
+}
+
 func (s *ScoutServer) CreateGame(ctx context.Context, req *pb.CreateGameRequest) (*pb.CreateGameResponse, error) {
-	game, err := NewGame(int(req.NumPlayers))
+	// debug logs show every hand, and are written whatever the server's log level
+	if req.Config.GetDebugLog() && !s.isAdmin(ctx) {
+		return nil, status.Error(codes.PermissionDenied, "only admins can turn on debug_log")
+	}
+
+	newGame := NewGame
+	if req.Open {
+		newGame = NewOpenGame
+	}
+	game, err := newGame(int(req.NumPlayers))
 	if err != nil {
 		return nil, err
 	}
+	if err := game.Configure(s.gameConfig(req.Config)); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := s.addGame(game); err != nil {
+		return nil, err
+	}
 
-	s.mu.Lock()
-	s.Games[game.Id] = game
-	s.mu.Unlock()
-
-	return &pb.CreateGameResponse{GameId: game.Id}, nil
+	// the creator hands out the seats of a closed game; open game seats get theirs on joining
+	resp := &pb.CreateGameResponse{GameId: game.Id}
+	if !req.Open {
+		resp.SeatTokens = game.seatTokens()
+		for i := range resp.SeatTokens {
+			s.giveSeat(ctx, game, i)
+		}
+	}
+	return resp, nil
 }
 
 func (s *ScoutServer) PlayerAction(ctx context.Context, req *pb.PlayerActionRequest) (*pb.PlayerActionResponse, error) {
 	// Read-lock just to find the game pointer.
-	s.mu.RLock()
-	game := s.Games[req.GameId]
-	s.mu.RUnlock()
+	game := s.store.Get(req.GameId)
 
 	if game == nil {
-		return nil, fmt.Errorf("invalid game_id")
+		return nil, errInvalidGameId
+	}
+	if err := s.authorizeSeat(ctx, game, int(req.PlayerIndex)); err != nil {
+		return nil, err
 	}
 
 	err := game.PlayerAction(int(req.PlayerIndex), ToActionSpec(req.Action))
-
-	var msg string
-	if err != nil {
-		msg = err.Error()
+	if err == nil {
+		s.gameChanged(game)
+	} else if req.StatusErrors {
+		return nil, violationStatus(err)
 	}
-	return &pb.PlayerActionResponse{
-		Err:    err != nil,
-		ErrMsg: msg,
-	}, nil
+	return ToActionResponse(err), nil
 }
 
 func (s *ScoutServer) GetGameState(ctx context.Context, req *pb.GetGameStateRequest) (*pb.GetGameStateResponse, error) {
-	s.mu.RLock()
-	game := s.Games[req.GameId]
-	s.mu.RUnlock()
+	game := s.store.Get(req.GameId)
 
 	if game == nil {
-		return nil, fmt.Errorf("invalid game_id")
+		return nil, errInvalidGameId
 	}
 
 	// game.ToProto should be safe (either it locks internally or ToProto snapshots)
@@ -72,15 +190,16 @@ func (s *ScoutServer) GetGameState(ctx context.Context, req *pb.GetGameStateRequ
 }
 
 func (s *ScoutServer) GetPlayerState(ctx context.Context, req *pb.GetPlayerStateRequest) (*pb.GetPlayerStateResponse, error) {
-	s.mu.RLock()
-	game := s.Games[req.GameId]
-	s.mu.RUnlock()
+	game := s.store.Get(req.GameId)
 
 	if game == nil {
-		return nil, fmt.Errorf("invalid game_id")
+		return nil, errInvalidGameId
 	}
 	if int(req.PlayerIndex) < 0 || int(req.PlayerIndex) >= len(game.Players) {
-		return nil, fmt.Errorf("invalid player_index")
+		return nil, errInvalidPlayerIndex
+	}
+	if err := s.authorizeSeat(ctx, game, int(req.PlayerIndex)); err != nil {
+		return nil, err
 	}
 
 	// game.Players[...] access should be safe (again: game-level lock ideally)
@@ -90,23 +209,116 @@ func (s *ScoutServer) GetPlayerState(ctx context.Context, req *pb.GetPlayerState
 }
 
 func (s *ScoutServer) GetValidActions(ctx context.Context, req *pb.GetValidActionsRequest) (*pb.GetValidActionsResponse, error) {
-	s.mu.RLock()
-	game := s.Games[req.GameId]
-	s.mu.RUnlock()
+	game := s.store.Get(req.GameId)
 
 	if game == nil {
-		return nil, fmt.Errorf("invalid game_id")
+		return nil, errInvalidGameId
 	}
 	if int(req.PlayerIndex) < 0 || int(req.PlayerIndex) >= len(game.Players) {
-		return nil, fmt.Errorf("invalid player_index")
+		return nil, errInvalidPlayerIndex
+	}
+	if err := s.authorizeSeat(ctx, game, int(req.PlayerIndex)); err != nil {
+		return nil, err
+	}
+
+	return &pb.GetValidActionsResponse{Mask: s.actionMask(game, int(req.PlayerIndex))}, nil
+}
+
+func (s *ScoutServer) Determinize(ctx context.Context, req *pb.DeterminizeRequest) (*pb.DeterminizeResponse, error) {
+	game := s.store.Get(req.GameId)
+
+	if game == nil {
+		return nil, errInvalidGameId
+	}
+	if err := s.authorizeSeat(ctx, game, int(req.PlayerIndex)); err != nil {
+		return nil, err
 	}
 
-	player := game.Players[req.PlayerIndex]
-	mask := make([]bool, len(s.AllActions))
+	samples, err := game.Determinize(int(req.PlayerIndex), int(req.NumSamples), req.Seed)
+	if err != nil {
+		return nil, err
+	}
 
-	for _, action := range s.AllActions {
-		mask[action.ID] = game.IsActionValid(player.Index, &action)
+	resp := &pb.DeterminizeResponse{}
+	for _, sample := range samples {
+		determinization := &pb.Determinization{Game: sample.ToProto()}
+		for _, player := range sample.Players {
+			determinization.Players = append(determinization.Players, player.ToProto())
+		}
+		resp.Samples = append(resp.Samples, determinization)
 	}
 
-	return &pb.GetValidActionsResponse{Mask: mask}, nil
+	return resp, nil
+}
+
+func (s *ScoutServer) WatchGame(req *pb.WatchGameRequest, stream pb.ScoutService_WatchGameServer) error {
+	game := s.store.Get(req.GameId)
+
+	if game == nil {
+		return errInvalidGameId
+	}
+
+	// players watch their own seat live; spectators see what the game lets them
+	visibility, delay := VisibilityPublic, 0
+	if req.Spectator {
+		visibility, delay = Visibility(req.Visibility), int(req.Delay)
+		if delay < 0 {
+			return status.Error(codes.InvalidArgument, "delay must not be negative")
+		}
+		if role, _ := s.role(stream.Context(), game); role != RoleAdmin && !game.Config.allowsSpectator(visibility, delay) {
+			return status.Error(codes.PermissionDenied, "game does not allow spectators that visibility")
+		}
+	} else {
+		if int(req.PlayerIndex) < 0 || int(req.PlayerIndex) >= len(game.Players) {
+			return errInvalidPlayerIndex
+		}
+		if err := s.authorizeSeat(stream.Context(), game, int(req.PlayerIndex)); err != nil {
+			return err
+		}
+	}
+
+	held := &delayedEvents{}
+	switch visibility {
+	case VisibilityFullDelayed:
+		held.delay = delay
+	case VisibilityFullAfterComplete:
+		held.delay = -1
+	}
+	send := func(events []*Event) error {
+		for _, e := range events {
+			event := e.ToProto(int(req.PlayerIndex), req.Spectator)
+			if visibility != VisibilityPublic {
+				event = e.FullProto()
+			}
+			if err := stream.Send(event); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	sub := game.Subscribe(WATCH_BUFFER_SIZE)
+	defer game.Unsubscribe(sub)
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		case e, ok := <-sub.C:
+			if !ok {
+				if sub.Lagged() {
+					// the client could not keep up; it can resync with GetGameState and watch again
+					return status.Errorf(codes.ResourceExhausted, "watcher fell more than %d events behind", WATCH_BUFFER_SIZE)
+				}
+				if game.Phase() != PhaseComplete {
+					return status.Error(codes.Aborted, "game was deleted")
+				}
+				// the game is over, so nothing needs holding back any more
+				return send(held.flush())
+			}
+			if err := send(held.push(e)); err != nil {
+				return err
+			}
+		}
+	}
 }
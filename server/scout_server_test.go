@@ -0,0 +1,69 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "scout-go/proto"
+)
+
+func TestPlayerActionViolations(t *testing.T) {
+	s := NewScoutServer()
+	ctx := context.Background()
+	created, _ := s.CreateGame(ctx, &pb.CreateGameRequest{NumPlayers: 2})
+
+	// seat 1 can't move first; the response says why
+	req := &pb.PlayerActionRequest{GameId: created.GameId, PlayerIndex: 1, Action: &pb.Action{ActionType: pb.Action_ActionShow, ShowLength: 1}}
+	resp, err := s.PlayerAction(asSeat(created.SeatTokens[1]), req)
+	if err != nil {
+		t.Fatalf("PlayerAction returned err: %v", err)
+	}
+	if !resp.Err || resp.ErrMsg != "not your turn" || resp.ViolationCode != pb.ViolationCode_ViolationNotYourTurn {
+		t.Fatalf("expected a not your turn violation, got %v", resp)
+	}
+
+	// or, on request, as a status with the violation in its details
+	req.StatusErrors = true
+	_, err = s.PlayerAction(asSeat(created.SeatTokens[1]), req)
+	st := status.Convert(err)
+	if st.Code() != codes.FailedPrecondition || len(st.Details()) != 1 {
+		t.Fatalf("expected FailedPrecondition with details, got %v", err)
+	}
+	if v, ok := st.Details()[0].(*pb.RuleViolation); !ok || v.Code != pb.ViolationCode_ViolationNotYourTurn {
+		t.Fatalf("expected a RuleViolation detail, got %v", st.Details()[0])
+	}
+
+	req.PlayerIndex = 0
+	req.Action.ShowLength = 99
+	_, err = s.PlayerAction(asSeat(created.SeatTokens[0]), req)
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument for a bad move, got %v", err)
+	}
+	if v := status.Convert(err).Details()[0].(*pb.RuleViolation); v.Message != "action is invalid: index out of range" {
+		t.Fatalf("expected the status to say what was wrong, got %q", v.Message)
+	}
+
+	// a bad move's errMsg is the same as it has always been, whatever was wrong with it
+	req.StatusErrors = false
+	resp, _ = s.PlayerAction(asSeat(created.SeatTokens[0]), req)
+	if resp.ErrMsg != "action is invalid" || resp.ViolationCode != pb.ViolationCode_ViolationIndexOutOfRange {
+		t.Fatalf("expected an invalid action with its code, got %v", resp)
+	}
+}
+
+func TestUnknownGameAndSeat(t *testing.T) {
+	s := NewScoutServer()
+	ctx := context.Background()
+	created, _ := s.CreateGame(ctx, &pb.CreateGameRequest{NumPlayers: 2})
+
+	if _, err := s.GetGameState(ctx, &pb.GetGameStateRequest{GameId: "nope"}); status.Code(err) != codes.NotFound {
+		t.Fatalf("expected NotFound for an unknown game, got %v", err)
+	}
+	_, err := s.GetValidActions(asSeat(created.SeatTokens[0]), &pb.GetValidActionsRequest{GameId: created.GameId, PlayerIndex: 7})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument for an unknown seat, got %v", err)
+	}
+}
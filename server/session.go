@@ -0,0 +1,149 @@
+package server
+
+import (
+	"io"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "scout-go/proto"
+)
+
+// PlaySession lets a client play a seat over a single stream. the client joins a seat,
+// then the server prompts it with its observation and mask whenever it is the seat's turn,
+// answers each action with a result, and forwards the game's events as they happen.
+// the seat is released when the stream ends.
+func (s *ScoutServer) PlaySession(stream pb.ScoutService_PlaySessionServer) error {
+	req, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	join := req.GetJoin()
+	if join == nil {
+		return status.Error(codes.FailedPrecondition, "first message must join a seat")
+	}
+
+	game := s.store.Get(join.GameId)
+
+	if game == nil {
+		return errInvalidGameId
+	}
+	seat := int(join.PlayerIndex)
+	if seat < 0 || seat >= len(game.Players) {
+		return errInvalidPlayerIndex
+	}
+	if err := s.authorizeSeat(stream.Context(), game, seat); err != nil {
+		return err
+	}
+
+	if !s.claimSeat(game.Id, seat) {
+		return status.Errorf(codes.AlreadyExists, "seat %d is already in a session", seat)
+	}
+	defer s.releaseSeat(game.Id, seat)
+
+	sub := game.Subscribe(WATCH_BUFFER_SIZE)
+	defer game.Unsubscribe(sub)
+
+	game.mu.RLock()
+	joined := game.Players[seat].ToProto()
+	game.mu.RUnlock()
+	if err := stream.Send(&pb.PlaySessionResponse{Response: &pb.PlaySessionResponse_Joined{Joined: joined}}); err != nil {
+		return err
+	}
+
+	// read the client's actions on their own goroutine, so we only ever send from this one
+	actions := make(chan *pb.Action)
+	recvErr := make(chan error, 1)
+	go func() {
+		for {
+			req, err := stream.Recv()
+			if err != nil {
+				recvErr <- err
+				return
+			}
+			if action := req.GetAction(); action != nil {
+				select {
+				case actions <- action:
+				case <-stream.Context().Done():
+					return
+				}
+			}
+		}
+	}()
+
+	// prompt the client once per turn. the turn is told apart by its number rather than by the
+	// events since the last prompt, which may arrive after the next turn has begun.
+	prompted := int64(-1)
+	for {
+		if turn, ok := game.seatTurn(seat); ok && turn != prompted {
+			prompt := &pb.Prompt{
+				Observation: game.ObservationProto(seat),
+				Mask:        s.actionMask(game, seat),
+			}
+			if err := stream.Send(&pb.PlaySessionResponse{Response: &pb.PlaySessionResponse_Prompt{Prompt: prompt}}); err != nil {
+				return err
+			}
+			prompted = turn
+		}
+
+		select {
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+
+		case err := <-recvErr:
+			if err == io.EOF {
+				return nil
+			}
+			return err
+
+		case action := <-actions:
+			err := game.PlayerAction(seat, ToActionSpec(action))
+			if err == nil {
+				s.gameChanged(game)
+			}
+			result := ToActionResponse(err)
+			if err := stream.Send(&pb.PlaySessionResponse{Response: &pb.PlaySessionResponse_Result{Result: result}}); err != nil {
+				return err
+			}
+
+		case e, ok := <-sub.C:
+			if !ok {
+				if sub.Lagged() {
+					return status.Errorf(codes.ResourceExhausted, "session fell more than %d events behind", WATCH_BUFFER_SIZE)
+				}
+				if game.Phase() != PhaseComplete {
+					return status.Error(codes.Aborted, "game was deleted")
+				}
+				return nil
+			}
+			if err := stream.Send(&pb.PlaySessionResponse{Response: &pb.PlaySessionResponse_Event{Event: e.ToProto(seat, false)}}); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// claimSeat marks the seat as played by a session; it returns false if it already is
+func (s *ScoutServer) claimSeat(gameId string, seat int) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.sessions[gameId] == nil {
+		s.sessions[gameId] = make(map[int]bool)
+	}
+	if s.sessions[gameId][seat] {
+		return false
+	}
+	s.sessions[gameId][seat] = true
+	return true
+}
+
+func (s *ScoutServer) releaseSeat(gameId string, seat int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.sessions[gameId], seat)
+	if len(s.sessions[gameId]) == 0 {
+		delete(s.sessions, gameId)
+	}
+}
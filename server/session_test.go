@@ -0,0 +1,210 @@
+package server
+
+import (
+	"context"
+	"io"
+	"math/rand/v2"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "scout-go/proto"
+)
+
+// playSeat joins a seat and plays until the game ends, showing the first set it can and
+// otherwise taking the first action in its mask
+func playSeat(client pb.ScoutServiceClient, gameId string, seat int32, token string) error {
+	stream, err := client.PlaySession(dialAsSeat(token))
+	if err != nil {
+		return err
+	}
+	if err := stream.Send(&pb.PlaySessionRequest{Request: &pb.PlaySessionRequest_Join{Join: &pb.JoinSeat{GameId: gameId, PlayerIndex: seat}}}); err != nil {
+		return err
+	}
+	for {
+		resp, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if prompt := resp.GetPrompt(); prompt != nil {
+			action := showFirst(prompt.Mask)
+			req := &pb.PlaySessionRequest{Request: &pb.PlaySessionRequest_Action{Action: action.ToProto()}}
+			if err := stream.Send(req); err != nil {
+				return err
+			}
+		}
+		if result := resp.GetResult(); result != nil && result.Err {
+			return status.Error(codes.Internal, result.ErrMsg)
+		}
+	}
+}
+
+// showFirst picks the first set the mask allows showing, and otherwise its first action
+func showFirst(mask []bool) ActionSpec {
+	choice := -1
+	for id, ok := range mask {
+		if ok && (choice < 0 || AllActions()[id].Type == ActionShow) {
+			choice = id
+		}
+		if ok && AllActions()[id].Type == ActionShow {
+			break
+		}
+	}
+	return AllActions()[choice]
+}
+
+func TestPlaySession(t *testing.T) {
+	s := NewScoutServer()
+	client := dialServer(t, s)
+
+	// the engine allows games that never end, so play a seed we know finishes
+	game, err := NewSeededGame(3, 1)
+	if err != nil {
+		t.Fatalf("NewSeededGame returned err: %v", err)
+	}
+	s.store.Add(game)
+
+	errs := make(chan error, 3)
+	for seat := int32(0); seat < 3; seat++ {
+		go func() { errs <- playSeat(client, game.Id, seat, game.SeatToken(int(seat))) }()
+	}
+	for i := 0; i < 3; i++ {
+		select {
+		case err := <-errs:
+			if err != nil {
+				t.Fatalf("session returned err: %v", err)
+			}
+		case <-time.After(30 * time.Second):
+			t.Fatalf("game did not finish")
+		}
+	}
+	if game.Phase() != PhaseComplete {
+		t.Fatalf("expected the game to be complete")
+	}
+}
+
+func TestPlaySessionNeedsSeatToken(t *testing.T) {
+	s := NewScoutServer()
+	client := dialServer(t, s)
+
+	created, _ := client.CreateGame(context.Background(), &pb.CreateGameRequest{NumPlayers: 2})
+	stream, _ := client.PlaySession(dialAsSeat(created.SeatTokens[0]))
+	stream.Send(&pb.PlaySessionRequest{Request: &pb.PlaySessionRequest_Join{Join: &pb.JoinSeat{GameId: created.GameId, PlayerIndex: 1}}})
+	if _, err := stream.Recv(); status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("expected PermissionDenied, got %v", err)
+	}
+}
+
+func TestPlaySessionSeatIsExclusive(t *testing.T) {
+	s := NewScoutServer()
+	client := dialServer(t, s)
+
+	created, _ := client.CreateGame(context.Background(), &pb.CreateGameRequest{NumPlayers: 2})
+	join := &pb.PlaySessionRequest{Request: &pb.PlaySessionRequest_Join{Join: &pb.JoinSeat{GameId: created.GameId, PlayerIndex: 1}}}
+
+	ctx, cancel := context.WithCancel(dialAsSeat(created.SeatTokens[1]))
+	first, _ := client.PlaySession(ctx)
+	first.Send(join)
+	if resp, err := first.Recv(); err != nil || resp.GetJoined() == nil {
+		t.Fatalf("expected to join, got %v, %v", resp, err)
+	}
+
+	second, _ := client.PlaySession(dialAsSeat(created.SeatTokens[1]))
+	second.Send(join)
+	if _, err := second.Recv(); status.Code(err) != codes.AlreadyExists {
+		t.Fatalf("expected AlreadyExists, got %v", err)
+	}
+
+	// disconnecting releases the seat
+	cancel()
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		third, _ := client.PlaySession(dialAsSeat(created.SeatTokens[1]))
+		third.Send(join)
+		resp, err := third.Recv()
+		if err == nil && resp.GetJoined() != nil {
+			third.CloseSend()
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("seat was not released: %v", err)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+// policyOpponent is a session's stream for seat 0 of a two player game whose seat 1 is played
+// by a policy. it answers every prompt, and seat 1 moves as soon as seat 0 has, before the
+// session sends seat 0 its result, as a server-driven agent may.
+type policyOpponent struct {
+	grpc.ServerStream
+	ctx  context.Context
+	game *Game
+	reqs chan *pb.PlaySessionRequest
+	r    *rand.Rand
+}
+
+func (o *policyOpponent) Context() context.Context { return o.ctx }
+
+func (o *policyOpponent) Recv() (*pb.PlaySessionRequest, error) {
+	select {
+	case req := <-o.reqs:
+		return req, nil
+	case <-o.ctx.Done():
+		return nil, o.ctx.Err()
+	}
+}
+
+func (o *policyOpponent) Send(resp *pb.PlaySessionResponse) error {
+	if prompt := resp.GetPrompt(); prompt != nil {
+		action := showFirst(prompt.Mask)
+		o.reqs <- &pb.PlaySessionRequest{Request: &pb.PlaySessionRequest_Action{Action: action.ToProto()}}
+	}
+	if result := resp.GetResult(); result != nil {
+		if result.Err {
+			return status.Error(codes.Internal, result.ErrMsg)
+		}
+		for seat, phase := o.game.turn(); phase == PhasePlaying && seat == 1; seat, phase = o.game.turn() {
+			action := GreedyPolicy{}.ChooseAction(o.game, 1, o.game.LegalActions(1), o.r)
+			if err := o.game.PlayerAction(1, &action); err != nil {
+				return status.Error(codes.Internal, err.Error())
+			}
+		}
+	}
+	return nil
+}
+
+func TestPlaySessionAgainstPolicy(t *testing.T) {
+	s := NewScoutServer()
+	game, err := NewSeededGame(2, 1)
+	if err != nil {
+		t.Fatalf("NewSeededGame returned err: %v", err)
+	}
+	s.store.Add(game)
+
+	ctx, cancel := context.WithCancel(asSeat(game.SeatToken(0)))
+	defer cancel()
+	stream := &policyOpponent{ctx: ctx, game: game, reqs: make(chan *pb.PlaySessionRequest, 1000), r: rand.New(rand.NewPCG(1, 2))}
+	stream.reqs <- &pb.PlaySessionRequest{Request: &pb.PlaySessionRequest_Join{Join: &pb.JoinSeat{GameId: game.Id, PlayerIndex: 0}}}
+
+	// seat 0 is prompted once per turn, so each answer is in turn
+	errs := make(chan error, 1)
+	go func() { errs <- s.PlaySession(stream) }()
+	select {
+	case err := <-errs:
+		if err != nil {
+			t.Fatalf("session returned err: %v", err)
+		}
+	case <-time.After(30 * time.Second):
+		t.Fatalf("game did not finish")
+	}
+	if game.Phase() != PhaseComplete {
+		t.Fatalf("expected the game to be complete")
+	}
+}
@@ -0,0 +1,306 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "scout-go/proto"
+)
+
+// SNAPSHOT_VERSION is bumped whenever GameSnapshot changes in a way older servers can't read
+const SNAPSHOT_VERSION = 1
+
+// Snapshot returns everything needed to restore the game exactly as it stands: every hand,
+// which way up each card is, the deck's random state, every move played, and the position
+// the game started from if it wasn't dealt. seat tokens are left out; a restored game hands
+// out new ones.
+func (g *Game) Snapshot() *pb.GameSnapshot {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	rngState, err := g.src.MarshalBinary()
+	if err != nil {
+		panic(err) // PCG never fails to marshal
+	}
+
+	snapshot := &pb.GameSnapshot{
+		Version:              SNAPSHOT_VERSION,
+		Id:                   g.Id,
+		NumPlayers:           int32(g.NumPlayers),
+		ActivePlayerIndex:    int32(g.ActivePlayer.Index),
+		ActiveSet:            cardSnapshots(g.ActiveSet),
+		ActiveSetPlayerIndex: -1,
+		ConsecutiveScouts:    int32(g.ConsecutiveScouts),
+		Round:                int32(g.Round),
+		Complete:             g.Complete,
+		Lobby:                g.Lobby,
+		Seed:                 g.Seed,
+		RngState:             rngState,
+		Config:               g.Config.ToProto(),
+		CreatedAtMs:          g.CreatedAt.UnixMilli(),
+		UpdatedAtMs:          g.UpdatedAt.UnixMilli(),
+	}
+	if g.ActiveSetPlayer != nil {
+		snapshot.ActiveSetPlayerIndex = int32(g.ActiveSetPlayer.Index)
+	}
+	if g.Start != nil {
+		snapshot.Start = positionSnapshot(*g.Start)
+	}
+	for _, p := range g.Players {
+		total, _ := g.timeLeft(p.Index)
+		snapshot.Players = append(snapshot.Players, &pb.PlayerSnapshot{
+			Name:            p.Name,
+			Index:           int32(p.Index),
+			Score:           int32(p.Score),
+			Hand:            cardSnapshots(p.Hand),
+			CanReverseHand:  p.CanReverseHand,
+			CanScoutAndShow: p.CanScoutAndShow,
+			Seated:          p.Seated,
+			Ready:           p.Ready,
+			TimeRemainingMs: total.Milliseconds(),
+		})
+	}
+	for _, move := range g.History {
+		snapshot.History = append(snapshot.History, &pb.Move{
+			PlayerIndex: int32(move.PlayerIndex),
+			Action:      move.Action.ToProto(),
+			Timeout:     move.Timeout,
+		})
+	}
+	return snapshot
+}
+
+// RestoreGame rebuilds a game from its snapshot. the game keeps the snapshot's id; every
+// seated seat gets a new token.
+func RestoreGame(snapshot *pb.GameSnapshot) (*Game, error) {
+	if snapshot.GetVersion() != SNAPSHOT_VERSION {
+		return nil, fmt.Errorf("unsupported snapshot version %d", snapshot.GetVersion())
+	}
+	numPlayers := int(snapshot.NumPlayers)
+	if numPlayers < 2 || numPlayers > 5 {
+		return nil, fmt.Errorf("invalid num_players %d", numPlayers)
+	}
+	if len(snapshot.Players) != numPlayers {
+		return nil, fmt.Errorf("snapshot has %d players for %d seats", len(snapshot.Players), numPlayers)
+	}
+	inRange := func(i int32) bool { return i >= 0 && int(i) < numPlayers }
+	if !inRange(snapshot.ActivePlayerIndex) {
+		return nil, fmt.Errorf("invalid active_player_index %d", snapshot.ActivePlayerIndex)
+	}
+	if snapshot.ActiveSetPlayerIndex != -1 && !inRange(snapshot.ActiveSetPlayerIndex) {
+		return nil, fmt.Errorf("invalid active_set_player_index %d", snapshot.ActiveSetPlayerIndex)
+	}
+	if snapshot.ConsecutiveScouts < 0 || snapshot.Round < 0 {
+		return nil, fmt.Errorf("consecutive_scouts and round must not be negative")
+	}
+
+	src := &rand.PCG{}
+	if err := src.UnmarshalBinary(snapshot.RngState); err != nil {
+		return nil, fmt.Errorf("invalid rng_state: %v", err)
+	}
+
+	g := &Game{
+		Id:                snapshot.Id,
+		NumPlayers:        numPlayers,
+		Players:           make([]*Player, numPlayers),
+		ConsecutiveScouts: int(snapshot.ConsecutiveScouts),
+		Round:             int(snapshot.Round),
+		Complete:          snapshot.Complete,
+		Lobby:             snapshot.Lobby,
+		Seed:              snapshot.Seed,
+		CreatedAt:         time.UnixMilli(snapshot.CreatedAtMs),
+		UpdatedAt:         time.UnixMilli(snapshot.UpdatedAtMs),
+		tokens:            make([]string, numPlayers),
+		tokenHashes:       make([]string, numPlayers),
+		owners:            make([]string, numPlayers),
+		src:               src,
+		rng:               rand.New(src),
+	}
+	if g.Id == "" {
+		g.Id = uuid.New().String()
+	}
+
+	var err error
+	if g.ActiveSet, err = restoreCards(snapshot.ActiveSet); err != nil {
+		return nil, fmt.Errorf("active_set: %v", err)
+	}
+	for i, ps := range snapshot.Players {
+		if int(ps.Index) != i {
+			return nil, fmt.Errorf("player %d has index %d", i, ps.Index)
+		}
+		hand, err := restoreCards(ps.Hand)
+		if err != nil {
+			return nil, fmt.Errorf("player %d hand: %v", i, err)
+		}
+		g.Players[i] = &Player{
+			Name:            ps.Name,
+			Index:           i,
+			Score:           int(ps.Score),
+			Hand:            hand,
+			CanReverseHand:  ps.CanReverseHand,
+			CanScoutAndShow: ps.CanScoutAndShow,
+			Seated:          ps.Seated,
+			Ready:           ps.Ready,
+		}
+		if ps.Seated {
+			g.newSeatToken(i)
+		}
+	}
+	g.ActivePlayer = g.Players[snapshot.ActivePlayerIndex]
+	if snapshot.ActiveSetPlayerIndex != -1 {
+		g.ActiveSetPlayer = g.Players[snapshot.ActiveSetPlayerIndex]
+	}
+
+	if snapshot.Start != nil {
+		start, err := restorePosition(snapshot.Start)
+		if err != nil {
+			return nil, fmt.Errorf("start: %v", err)
+		}
+		if len(start.Seats) != numPlayers {
+			return nil, fmt.Errorf("start has %d seats for %d players", len(start.Seats), numPlayers)
+		}
+		g.Start = &start
+	}
+
+	if err := g.Configure(ToGameConfig(snapshot.Config)); err != nil {
+		return nil, err
+	}
+
+	history := make([]Move, 0, len(snapshot.History))
+	for i, move := range snapshot.History {
+		if !inRange(move.PlayerIndex) || move.Action == nil {
+			return nil, fmt.Errorf("invalid move %d", i)
+		}
+		action := ToActionSpec(move.Action)
+		action.ID = int(move.Action.Id)
+		history = append(history, Move{PlayerIndex: int(move.PlayerIndex), Action: *action, Timeout: move.Timeout})
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.History = history
+	if g.Config.TotalTime > 0 {
+		for i, ps := range snapshot.Players {
+			g.clocks[i] = max(time.Duration(ps.TimeRemainingMs)*time.Millisecond, 0)
+		}
+	}
+	// the seat to move gets its turn over again
+	if g.phase() == PhasePlaying {
+		g.startTurn()
+	}
+	return g, nil
+}
+
+func positionSnapshot(state GameState) *pb.PositionSnapshot {
+	snapshot := &pb.PositionSnapshot{
+		ActiveSet:            cardSnapshots(state.ActiveSet),
+		ActiveSetPlayerIndex: int32(state.ActiveSetPlayer),
+		ActivePlayerIndex:    int32(state.ActivePlayer),
+		ConsecutiveScouts:    int32(state.ConsecutiveScouts),
+		Round:                int32(state.Round),
+	}
+	for _, seat := range state.Seats {
+		snapshot.Seats = append(snapshot.Seats, &pb.SeatSnapshot{
+			Hand:            cardSnapshots(seat.Hand),
+			Score:           int32(seat.Score),
+			CanReverseHand:  seat.CanReverseHand,
+			CanScoutAndShow: seat.CanScoutAndShow,
+		})
+	}
+	return snapshot
+}
+
+// restorePosition rebuilds a start position, checking it as NewGameFromState would
+func restorePosition(snapshot *pb.PositionSnapshot) (GameState, error) {
+	state := GameState{
+		ActiveSetPlayer:   int(snapshot.ActiveSetPlayerIndex),
+		ActivePlayer:      int(snapshot.ActivePlayerIndex),
+		ConsecutiveScouts: int(snapshot.ConsecutiveScouts),
+		Round:             int(snapshot.Round),
+	}
+	var err error
+	if state.ActiveSet, err = restoreCards(snapshot.ActiveSet); err != nil {
+		return GameState{}, fmt.Errorf("active_set: %v", err)
+	}
+	for i, seat := range snapshot.Seats {
+		hand, err := restoreCards(seat.Hand)
+		if err != nil {
+			return GameState{}, fmt.Errorf("seat %d hand: %v", i, err)
+		}
+		state.Seats = append(state.Seats, SeatState{
+			Hand:            hand,
+			Score:           int(seat.Score),
+			CanReverseHand:  seat.CanReverseHand,
+			CanScoutAndShow: seat.CanScoutAndShow,
+		})
+	}
+	if err := state.validate(); err != nil {
+		return GameState{}, err
+	}
+	return state, nil
+}
+
+func cardSnapshots(cards []*Card) []*pb.CardSnapshot {
+	snapshots := make([]*pb.CardSnapshot, 0, len(cards))
+	for _, card := range cards {
+		snapshots = append(snapshots, &pb.CardSnapshot{
+			Value1: int32(card.Value1),
+			Value2: int32(card.Value2),
+			Public: card.Public,
+		})
+	}
+	return snapshots
+}
+
+func restoreCards(snapshots []*pb.CardSnapshot) ([]*Card, error) {
+	cards := make([]*Card, 0, len(snapshots))
+	for _, snapshot := range snapshots {
+		if snapshot.Value1 < 1 || snapshot.Value1 > 10 || snapshot.Value2 < 1 || snapshot.Value2 > 10 {
+			return nil, fmt.Errorf("invalid card %d/%d", snapshot.Value1, snapshot.Value2)
+		}
+		cards = append(cards, &Card{Value1: int(snapshot.Value1), Value2: int(snapshot.Value2), Public: snapshot.Public})
+	}
+	return cards, nil
+}
+
+func (s *ScoutServer) ExportGame(ctx context.Context, req *pb.ExportGameRequest) (*pb.ExportGameResponse, error) {
+	if !s.isAdmin(ctx) {
+		return nil, status.Error(codes.PermissionDenied, "only admins can export games")
+	}
+	game := s.store.Get(req.GameId)
+	if game == nil {
+		return nil, errInvalidGameId
+	}
+
+	return &pb.ExportGameResponse{Snapshot: game.Snapshot()}, nil
+}
+
+// ImportGame restores a snapshot as a new game, with an id of its own so it can't collide
+// with the game it was taken from
+func (s *ScoutServer) ImportGame(ctx context.Context, req *pb.ImportGameRequest) (*pb.ImportGameResponse, error) {
+	if !s.isAdmin(ctx) {
+		return nil, status.Error(codes.PermissionDenied, "only admins can import games")
+	}
+	if req.Snapshot == nil {
+		return nil, status.Error(codes.InvalidArgument, "snapshot is required")
+	}
+
+	game, err := RestoreGame(req.Snapshot)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	game.Id = uuid.New().String()
+	if err := s.addGame(game); err != nil {
+		return nil, err
+	}
+	s.gameChanged(game)
+
+	return &pb.ImportGameResponse{GameId: game.Id, SeatTokens: game.seatTokens()}, nil
+}
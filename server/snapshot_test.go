@@ -0,0 +1,129 @@
+package server
+
+import (
+	"context"
+	"math/rand/v2"
+	"reflect"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	pb "scout-go/proto"
+)
+
+func TestSnapshotRoundTrip(t *testing.T) {
+	game, _ := NewSeededGame(3, 7)
+	r := rand.New(rand.NewPCG(3, 4))
+	for i := 0; i < 15; i++ {
+		seat, _ := game.turn()
+		action := RandomPolicy{}.ChooseAction(game, seat, game.LegalActions(seat), r)
+		if err := game.PlayerAction(seat, &action); err != nil {
+			t.Fatalf("move %d failed: %v", i, err)
+		}
+	}
+
+	data, err := proto.Marshal(game.Snapshot())
+	if err != nil {
+		t.Fatalf("Marshal returned err: %v", err)
+	}
+	var snapshot pb.GameSnapshot
+	if err := proto.Unmarshal(data, &snapshot); err != nil {
+		t.Fatalf("Unmarshal returned err: %v", err)
+	}
+	restored, err := RestoreGame(&snapshot)
+	if err != nil {
+		t.Fatalf("RestoreGame returned err: %v", err)
+	}
+
+	if restored.Id != game.Id || restored.Round != game.Round || restored.ConsecutiveScouts != game.ConsecutiveScouts {
+		t.Fatalf("expected the same game, round and scouts")
+	}
+	if !reflect.DeepEqual(restored.History, game.History) {
+		t.Fatalf("expected the same history")
+	}
+	if restored.ActivePlayer.Index != game.ActivePlayer.Index || !reflect.DeepEqual(restored.ActiveSet, game.ActiveSet) {
+		t.Fatalf("expected the same table")
+	}
+	if (restored.ActiveSetPlayer == nil) != (game.ActiveSetPlayer == nil) ||
+		(game.ActiveSetPlayer != nil && restored.ActiveSetPlayer.Index != game.ActiveSetPlayer.Index) {
+		t.Fatalf("expected the same owner of the active set")
+	}
+	for i, p := range game.Players {
+		q := restored.Players[i]
+		if !reflect.DeepEqual(q.Hand, p.Hand) || q.Score != p.Score || q.CanReverseHand != p.CanReverseHand || q.CanScoutAndShow != p.CanScoutAndShow {
+			t.Fatalf("seat %d: expected the same hand, score and flags", i)
+		}
+		if restored.SeatToken(i) == "" || restored.SeatToken(i) == game.SeatToken(i) {
+			t.Fatalf("seat %d: expected a new token", i)
+		}
+	}
+
+	// both games deal the same cards from here on
+	if restored.rng.Uint64() != game.rng.Uint64() {
+		t.Fatalf("expected the same random state")
+	}
+}
+
+func TestRestoreGameRejectsBadSnapshots(t *testing.T) {
+	game, _ := NewSeededGame(2, 1)
+
+	tests := []struct {
+		name   string
+		mutate func(*pb.GameSnapshot)
+	}{
+		{"unknown version", func(s *pb.GameSnapshot) { s.Version = SNAPSHOT_VERSION + 1 }},
+		{"too few players", func(s *pb.GameSnapshot) { s.NumPlayers = 1 }},
+		{"missing player", func(s *pb.GameSnapshot) { s.Players = s.Players[:1] }},
+		{"active player out of range", func(s *pb.GameSnapshot) { s.ActivePlayerIndex = 2 }},
+		{"bad card", func(s *pb.GameSnapshot) { s.Players[0].Hand[0].Value1 = 11 }},
+		{"bad rng state", func(s *pb.GameSnapshot) { s.RngState = []byte("nope") }},
+	}
+
+	for _, test := range tests {
+		snapshot := game.Snapshot()
+		test.mutate(snapshot)
+		if _, err := RestoreGame(snapshot); err == nil {
+			t.Fatalf("%s: expected an error", test.name)
+		}
+	}
+}
+
+func TestExportImportGame(t *testing.T) {
+	s := NewScoutServer(WithAdminToken("admin"))
+	ctx := context.Background()
+	admin := metadata.NewIncomingContext(ctx, metadata.Pairs(ADMIN_TOKEN_HEADER, "admin"))
+
+	created, _ := s.CreateGame(ctx, &pb.CreateGameRequest{NumPlayers: 2})
+	if _, err := s.ExportGame(asSeat(created.SeatTokens[0]), &pb.ExportGameRequest{GameId: created.GameId}); status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("expected seats to be denied, got %v", err)
+	}
+	exported, err := s.ExportGame(admin, &pb.ExportGameRequest{GameId: created.GameId})
+	if err != nil {
+		t.Fatalf("ExportGame returned err: %v", err)
+	}
+
+	if _, err := s.ImportGame(ctx, &pb.ImportGameRequest{Snapshot: exported.Snapshot}); status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("expected spectators to be denied, got %v", err)
+	}
+	imported, err := s.ImportGame(admin, &pb.ImportGameRequest{Snapshot: exported.Snapshot})
+	if err != nil {
+		t.Fatalf("ImportGame returned err: %v", err)
+	}
+	if imported.GameId == created.GameId || len(imported.SeatTokens) != 2 {
+		t.Fatalf("expected a new game with 2 seat tokens, got %v", imported)
+	}
+
+	// the import plays like the original
+	game := s.store.Get(imported.GameId)
+	seat, _ := game.turn()
+	action := game.LegalActions(seat)[0]
+	resp, err := s.PlayerAction(asSeat(imported.SeatTokens[seat]), &pb.PlayerActionRequest{
+		GameId: imported.GameId, PlayerIndex: int32(seat), Action: action.ToProto(),
+	})
+	if err != nil || resp.Err {
+		t.Fatalf("PlayerAction failed: %v %s", err, resp.GetErrMsg())
+	}
+}
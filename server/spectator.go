@@ -0,0 +1,63 @@
+package server
+
+const (
+	VisibilityPublic            Visibility = iota // only what the table sees
+	VisibilityFullDelayed                         // every hand, a number of actions behind the game
+	VisibilityFullAfterComplete                   // every hand, once the game is over
+)
+
+// Visibility is how much of a game a spectator sees
+type Visibility int
+
+// allowsSpectator reports whether spectators may watch the game with the given visibility.
+// full information is only ever allowed at least as late as the config says.
+func (c GameConfig) allowsSpectator(v Visibility, delay int) bool {
+	switch v {
+	case VisibilityPublic:
+		return true
+	case VisibilityFullAfterComplete:
+		return c.SpectatorVisibility != VisibilityPublic
+	case VisibilityFullDelayed:
+		return c.SpectatorVisibility == VisibilityFullDelayed && delay >= c.SpectatorDelay
+	default:
+		return false
+	}
+}
+
+// delayedEvents holds a watcher's events back until delay more actions have been applied
+// after them. a negative delay holds everything until the events are flushed.
+type delayedEvents struct {
+	delay int
+	queue []*Event
+}
+
+// push adds the event, and returns the events that are now far enough behind to be sent
+func (d *delayedEvents) push(e *Event) []*Event {
+	d.queue = append(d.queue, e)
+	if d.delay < 0 {
+		return nil
+	}
+
+	// everything before the delay'th most recent action can go
+	cut, actions := len(d.queue), 0
+	for cut > 0 && actions < d.delay {
+		cut--
+		if d.queue[cut].Type == EventActionApplied {
+			actions++
+		}
+	}
+	if actions < d.delay {
+		return nil
+	}
+
+	released := d.queue[:cut]
+	d.queue = d.queue[cut:]
+	return released
+}
+
+// flush returns every event still held
+func (d *delayedEvents) flush() []*Event {
+	released := d.queue
+	d.queue = nil
+	return released
+}
@@ -0,0 +1,128 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	pb "scout-go/proto"
+)
+
+func TestDelayedEvents(t *testing.T) {
+	action := &Event{Type: EventActionApplied}
+	score := &Event{Type: EventScoreChanged}
+
+	tests := []struct {
+		name     string
+		delay    int
+		events   []*Event
+		released []int // how many events each push releases
+	}{
+		{"no delay", 0, []*Event{action, score, action}, []int{1, 1, 1}},
+		{"one action", 1, []*Event{action, score, action, action}, []int{0, 0, 2, 1}},
+		{"two actions", 2, []*Event{action, action, score, action}, []int{0, 0, 0, 1}},
+		{"until complete", -1, []*Event{action, action, action}, []int{0, 0, 0}},
+	}
+
+	for _, test := range tests {
+		held := &delayedEvents{delay: test.delay}
+		total := 0
+		for i, e := range test.events {
+			released := held.push(e)
+			if len(released) != test.released[i] {
+				t.Fatalf("%s: push %d: expected %d events, got %d", test.name, i, test.released[i], len(released))
+			}
+			total += len(released)
+		}
+		if n := len(held.flush()); total+n != len(test.events) {
+			t.Fatalf("%s: expected flush to release the remaining %d events, got %d", test.name, len(test.events)-total, n)
+		}
+	}
+}
+
+func TestSpectatorVisibility(t *testing.T) {
+	tests := []struct {
+		name       string
+		config     *pb.GameConfig
+		visibility pb.Visibility
+		delay      int32
+		allowed    bool
+	}{
+		{"public game, public watcher", nil, pb.Visibility_VisibilityPublic, 0, true},
+		{"public game, full watcher", nil, pb.Visibility_VisibilityFullDelayed, 100, false},
+		{"public game, after complete", nil, pb.Visibility_VisibilityFullAfterComplete, 0, false},
+		{"delayed game, shorter delay", &pb.GameConfig{SpectatorVisibility: pb.Visibility_VisibilityFullDelayed.Enum(), SpectatorDelay: proto.Int32(3)}, pb.Visibility_VisibilityFullDelayed, 2, false},
+		{"delayed game, longer delay", &pb.GameConfig{SpectatorVisibility: pb.Visibility_VisibilityFullDelayed.Enum(), SpectatorDelay: proto.Int32(3)}, pb.Visibility_VisibilityFullDelayed, 4, true},
+		{"delayed game, after complete", &pb.GameConfig{SpectatorVisibility: pb.Visibility_VisibilityFullDelayed.Enum(), SpectatorDelay: proto.Int32(3)}, pb.Visibility_VisibilityFullAfterComplete, 0, true},
+		{"after complete game, delayed", &pb.GameConfig{SpectatorVisibility: pb.Visibility_VisibilityFullAfterComplete.Enum()}, pb.Visibility_VisibilityFullDelayed, 100, false},
+	}
+
+	for _, test := range tests {
+		s := NewScoutServer()
+		client := dialServer(t, s)
+		ctx := context.Background()
+
+		created, err := client.CreateGame(ctx, &pb.CreateGameRequest{NumPlayers: 2, Config: test.config})
+		if err != nil {
+			t.Fatalf("%s: CreateGame returned err: %v", test.name, err)
+		}
+		stream, err := client.WatchGame(ctx, &pb.WatchGameRequest{
+			GameId: created.GameId, Spectator: true, Visibility: test.visibility, Delay: test.delay,
+		})
+		if err != nil {
+			t.Fatalf("%s: WatchGame returned err: %v", test.name, err)
+		}
+		if !test.allowed {
+			if _, err := stream.Recv(); status.Code(err) != codes.PermissionDenied {
+				t.Fatalf("%s: expected PermissionDenied, got %v", test.name, err)
+			}
+			continue
+		}
+		// an allowed watcher is subscribed to the game
+		waitForSubscribers(t, s.store.Get(created.GameId), 1)
+	}
+}
+
+func TestFullSpectatorSeesHands(t *testing.T) {
+	s := NewScoutServer()
+	client := dialServer(t, s)
+	ctx := context.Background()
+
+	// a delay of 0 would show the players each other's hands
+	config := &pb.GameConfig{SpectatorVisibility: pb.Visibility_VisibilityFullDelayed.Enum()}
+	if _, err := client.CreateGame(ctx, &pb.CreateGameRequest{NumPlayers: 2, Config: config}); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument for no delay, got %v", err)
+	}
+
+	config.SpectatorDelay = proto.Int32(1)
+	created, _ := client.CreateGame(ctx, &pb.CreateGameRequest{NumPlayers: 2, Config: config})
+	stream, err := client.WatchGame(ctx, &pb.WatchGameRequest{
+		GameId: created.GameId, Spectator: true, Visibility: pb.Visibility_VisibilityFullDelayed, Delay: 1,
+	})
+	if err != nil {
+		t.Fatalf("WatchGame returned err: %v", err)
+	}
+	game := s.store.Get(created.GameId)
+	waitForSubscribers(t, game, 1)
+
+	show := &pb.Action{ActionType: pb.Action_ActionShow, ShowFirstIndex: 0, ShowLength: 1}
+	if resp, _ := client.PlayerAction(dialAsSeat(created.SeatTokens[0]), &pb.PlayerActionRequest{GameId: created.GameId, Action: show}); resp.Err {
+		t.Fatalf("show failed: %s", resp.ErrMsg)
+	}
+	// the show is sent once one more action has been applied
+	next := game.LegalActions(1)[0].ToProto()
+	if resp, _ := client.PlayerAction(dialAsSeat(created.SeatTokens[1]), &pb.PlayerActionRequest{GameId: created.GameId, PlayerIndex: 1, Action: next}); resp.Err {
+		t.Fatalf("second action failed: %s", resp.ErrMsg)
+	}
+
+	e, err := stream.Recv()
+	if err != nil {
+		t.Fatalf("Recv returned err: %v", err)
+	}
+	if len(e.Players) != 2 || len(e.Players[0].Hand) == 0 || len(e.Players[1].Hand) == 0 {
+		t.Fatalf("expected every seat's hand, got %v", e.Players)
+	}
+}
@@ -0,0 +1,157 @@
+package server
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "scout-go/proto"
+)
+
+// GameState is a position to start a game from, in place of a deal; see NewGameFromState
+type GameState struct {
+	Seats             []SeatState
+	ActiveSet         []*Card
+	ActiveSetPlayer   int // owner of the active set; ignored if it is empty
+	ActivePlayer      int
+	ConsecutiveScouts int
+	Round             int
+}
+
+// SeatState is one seat's part of a GameState
+type SeatState struct {
+	Hand            []*Card
+	Score           int
+	CanReverseHand  bool
+	CanScoutAndShow bool
+}
+
+// NewGameFromState starts a game at the given position. its cards must all come from the
+// deck for its number of players; cards not in a hand or the active set are out of play.
+// later rounds are dealt from seed.
+func NewGameFromState(state GameState, seed int64) (*Game, RulesViolation) {
+	if err := state.validate(); err != nil {
+		return nil, err
+	}
+	g, err := NewSeededGame(len(state.Seats), seed)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, seat := range state.Seats {
+		p := g.Players[i]
+		p.Hand = cloneCards(seat.Hand)
+		p.Score = seat.Score
+		p.CanReverseHand = seat.CanReverseHand
+		p.CanScoutAndShow = seat.CanScoutAndShow
+	}
+	g.ActiveSet = cloneCards(state.ActiveSet)
+	if len(g.ActiveSet) > 0 {
+		g.ActiveSetPlayer = g.Players[state.ActiveSetPlayer]
+	}
+	g.ActivePlayer = g.Players[state.ActivePlayer]
+	g.ConsecutiveScouts = state.ConsecutiveScouts
+	g.Round = state.Round
+	g.Start = &state
+	return g, nil
+}
+
+func (s GameState) validate() RulesViolation {
+	n := len(s.Seats)
+	cards, err := gameCards(n)
+	if err != nil {
+		return err
+	}
+	if s.ActivePlayer < 0 || s.ActivePlayer >= n {
+		return RulesViolation(fmt.Errorf("invalid active player %d", s.ActivePlayer))
+	}
+	if s.Round < 0 || s.Round >= n {
+		return RulesViolation(fmt.Errorf("invalid round %d", s.Round))
+	}
+	// the round would already be over
+	if s.ConsecutiveScouts < 0 || s.ConsecutiveScouts >= n-1 {
+		return RulesViolation(fmt.Errorf("invalid consecutive scouts %d", s.ConsecutiveScouts))
+	}
+	if len(s.ActiveSet) > 0 {
+		if s.ActiveSetPlayer < 0 || s.ActiveSetPlayer >= n {
+			return RulesViolation(fmt.Errorf("invalid active set player %d", s.ActiveSetPlayer))
+		}
+		if err := validateSet(s.ActiveSet); err != nil {
+			return err
+		}
+	}
+
+	// every card has to come out of the deck, whichever way up it is held
+	remaining := make(map[[2]int]int)
+	for _, card := range cards {
+		remaining[cardKey(card)]++
+	}
+	inPlay := [][]*Card{s.ActiveSet}
+	for _, seat := range s.Seats {
+		inPlay = append(inPlay, seat.Hand)
+	}
+	for _, group := range inPlay {
+		for _, card := range group {
+			key := cardKey(card)
+			if remaining[key] == 0 {
+				return RulesViolation(fmt.Errorf("card %d/%d is not in the deck, or is used twice", card.Value1, card.Value2))
+			}
+			remaining[key]--
+		}
+	}
+	return nil
+}
+
+// cardKey identifies a card regardless of orientation
+func cardKey(c *Card) [2]int {
+	return [2]int{min(c.Value1, c.Value2), max(c.Value1, c.Value2)}
+}
+
+// ToGameState converts the proto request's position
+func ToGameState(req *pb.CreateGameFromStateRequest) GameState {
+	state := GameState{
+		ActiveSet:         toCards(req.ActiveSet),
+		ActiveSetPlayer:   int(req.ActiveSetPlayerIndex),
+		ActivePlayer:      int(req.ActivePlayerIndex),
+		ConsecutiveScouts: int(req.ConsecutiveScouts),
+		Round:             int(req.Round),
+	}
+	for _, seat := range req.Seats {
+		state.Seats = append(state.Seats, SeatState{
+			Hand:            toCards(seat.Hand),
+			Score:           int(seat.Score),
+			CanReverseHand:  seat.CanReverseHand,
+			CanScoutAndShow: seat.CanScoutAndShow,
+		})
+	}
+	return state
+}
+
+func toCards(cards []*pb.Card) []*Card {
+	result := make([]*Card, 0, len(cards))
+	for _, card := range cards {
+		result = append(result, &Card{Value1: int(card.Value1), Value2: int(card.Value2)})
+	}
+	return result
+}
+
+func (s *ScoutServer) CreateGameFromState(ctx context.Context, req *pb.CreateGameFromStateRequest) (*pb.CreateGameFromStateResponse, error) {
+	if !s.isAdmin(ctx) {
+		return nil, status.Error(codes.PermissionDenied, "only admins can create games from a position")
+	}
+
+	game, err := NewGameFromState(ToGameState(req), req.Seed)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := game.Configure(s.gameConfig(req.Config)); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := s.addGame(game); err != nil {
+		return nil, err
+	}
+
+	return &pb.CreateGameFromStateResponse{GameId: game.Id, SeatTokens: game.seatTokens()}, nil
+}
@@ -0,0 +1,119 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	pb "scout-go/proto"
+)
+
+func cards(values ...[2]int) []*Card {
+	result := make([]*Card, 0, len(values))
+	for _, v := range values {
+		result = append(result, &Card{Value1: v[0], Value2: v[1]})
+	}
+	return result
+}
+
+func TestNewGameFromState(t *testing.T) {
+	valid := func() GameState {
+		return GameState{
+			Seats: []SeatState{
+				{Hand: cards([2]int{4, 3}), CanReverseHand: true, CanScoutAndShow: true},
+				{Hand: cards([2]int{5, 6}, [2]int{7, 10}), Score: 2, CanScoutAndShow: true},
+			},
+			ActiveSet:       cards([2]int{2, 1}),
+			ActiveSetPlayer: 1,
+		}
+	}
+
+	tests := []struct {
+		name   string
+		mutate func(*GameState)
+		valid  bool
+	}{
+		{"valid", func(s *GameState) {}, true},
+		{"card reversed", func(s *GameState) { s.Seats[0].Hand = cards([2]int{3, 4}) }, true},
+		{"card used twice", func(s *GameState) { s.Seats[0].Hand = cards([2]int{6, 5}) }, false},
+		{"card not in the deck", func(s *GameState) { s.Seats[0].Hand = cards([2]int{9, 10}) }, false},
+		{"invalid active set", func(s *GameState) { s.ActiveSet = cards([2]int{2, 1}, [2]int{8, 1}) }, false},
+		{"active set without an owner", func(s *GameState) { s.ActiveSetPlayer = 2 }, false},
+		{"active player out of range", func(s *GameState) { s.ActivePlayer = 2 }, false},
+		{"round out of range", func(s *GameState) { s.Round = 2 }, false},
+		{"round already over", func(s *GameState) { s.ConsecutiveScouts = 1 }, false},
+		{"too many seats", func(s *GameState) { s.Seats = make([]SeatState, 6) }, false},
+	}
+
+	for _, test := range tests {
+		state := valid()
+		test.mutate(&state)
+		_, err := NewGameFromState(state, 1)
+		if test.valid != (err == nil) {
+			t.Fatalf("%s: expected valid=%v, got err %v", test.name, test.valid, err)
+		}
+	}
+}
+
+func TestGameFromStateEndsRound(t *testing.T) {
+	game, err := NewGameFromState(GameState{
+		Seats: []SeatState{
+			{Hand: cards([2]int{3, 4}), CanReverseHand: true, CanScoutAndShow: true},
+			{Hand: cards([2]int{5, 6}, [2]int{7, 10}), CanReverseHand: true, CanScoutAndShow: true},
+		},
+		ActiveSet:       cards([2]int{2, 1}),
+		ActiveSetPlayer: 1,
+	}, 1)
+	if err != nil {
+		t.Fatalf("NewGameFromState returned err: %v", err)
+	}
+
+	// beating the active set with the last card in hand ends the round, and the next is dealt
+	if err := game.PlayerAction(0, &ActionSpec{Type: ActionShow, ShowFirstIndex: 0, ShowLength: 1}); err != nil {
+		t.Fatalf("PlayerAction returned err: %v", err)
+	}
+	if game.Round != 1 || game.Players[1].Score != -2 {
+		t.Fatalf("expected round 1 with seat 1 on -2, got round %d and %d", game.Round, game.Players[1].Score)
+	}
+	if len(game.Players[0].Hand) != len(game.Players[1].Hand) || len(game.Players[0].Hand) == 0 {
+		t.Fatalf("expected a new deal")
+	}
+}
+
+func TestCreateGameFromState(t *testing.T) {
+	s := NewScoutServer(WithAdminToken("admin"))
+	ctx := context.Background()
+	admin := metadata.NewIncomingContext(ctx, metadata.Pairs(ADMIN_TOKEN_HEADER, "admin"))
+
+	req := &pb.CreateGameFromStateRequest{
+		Seats: []*pb.SeatState{
+			{Hand: []*pb.Card{{Value1: 3, Value2: 4}}, CanScoutAndShow: true},
+			{Hand: []*pb.Card{{Value1: 5, Value2: 6}}, Score: 3},
+		},
+		ActivePlayerIndex: 1,
+	}
+	if _, err := s.CreateGameFromState(ctx, req); status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("expected callers without the admin token to be denied, got %v", err)
+	}
+	created, err := s.CreateGameFromState(admin, req)
+	if err != nil {
+		t.Fatalf("CreateGameFromState returned err: %v", err)
+	}
+
+	state := s.store.Get(created.GameId).ToProto()
+	if state.ActivePlayerIndex != 1 || state.PlayerStates[1].Score != 3 || state.PlayerStates[0].HandSize != 1 {
+		t.Fatalf("expected the given position, got %v", state)
+	}
+	player, err := s.GetPlayerState(asSeat(created.SeatTokens[0]), &pb.GetPlayerStateRequest{GameId: created.GameId})
+	if err != nil || player.Player.CanReverseHand || !player.Player.CanScoutAndShow {
+		t.Fatalf("expected the given flags, got %v %v", player, err)
+	}
+
+	req.Seats[1].Hand = req.Seats[0].Hand
+	if _, err := s.CreateGameFromState(admin, req); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected a card used twice to be rejected, got %v", err)
+	}
+}
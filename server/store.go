@@ -0,0 +1,74 @@
+package server
+
+import (
+	"fmt"
+	"sync"
+)
+
+// GameStore keeps the server's games. the server calls Save after every change to a game,
+// so stores that persist games can write it out.
+type GameStore interface {
+	Get(id string) *Game // nil if there is no such game
+	Add(game *Game) error
+	Save(game *Game) error
+	Delete(id string) error
+	List() []*Game
+}
+
+// WithStore keeps the server's games in store instead of in memory
+func WithStore(store GameStore) Option {
+	return func(s *ScoutServer) {
+		s.store = store
+	}
+}
+
+// MemoryStore keeps games in memory only; they are lost when the server stops
+type MemoryStore struct {
+	mu    sync.RWMutex
+	games map[string]*Game
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{games: make(map[string]*Game)}
+}
+
+func (m *MemoryStore) Get(id string) *Game {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return m.games[id]
+}
+
+func (m *MemoryStore) Add(game *Game) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.games[game.Id]; ok {
+		return fmt.Errorf("game %s already exists", game.Id)
+	}
+	m.games[game.Id] = game
+	return nil
+}
+
+func (m *MemoryStore) Save(game *Game) error {
+	return nil
+}
+
+func (m *MemoryStore) Delete(id string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.games, id)
+	return nil
+}
+
+func (m *MemoryStore) List() []*Game {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	games := make([]*Game, 0, len(m.games))
+	for _, game := range m.games {
+		games = append(games, game)
+	}
+	return games
+}
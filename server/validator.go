@@ -1,6 +1,7 @@
 package server
 
 import (
+	"errors"
 	"fmt"
 	"math"
 )
@@ -8,10 +9,94 @@ import (
 // Rules Validation
 type RulesViolation error
 
+// ViolationCode says which rule a RulesViolation broke
+type ViolationCode int
+
+const (
+	ViolationUnknown ViolationCode = iota
+	ViolationNotYourTurn
+	ViolationGameComplete
+	ViolationGameNotStarted
+	ViolationGameStarted
+	ViolationUnknownAction
+	ViolationIndexOutOfRange
+	ViolationNothingToScout
+	ViolationScoutNotFromEnd
+	ViolationSetEmpty
+	ViolationSetNotConsecutiveOrMatching
+	ViolationSetTooWeak
+	ViolationScoutAndShowUsed
+	ViolationReverseHandNotAllowed
+	ViolationGameFull
+	ViolationSeatTaken
+	ViolationSeatEmpty
+	ViolationNameTooLong
+)
+
+// Violation is a RulesViolation that carries its ViolationCode
+type Violation struct {
+	Code   ViolationCode
+	Detail string // what was wrong, when the message alone doesn't say
+	msg    string
+}
+
+func (v *Violation) Error() string {
+	return v.msg
+}
+
+// errActionInvalid is what every invalid action reports as its message, as it always has;
+// the rule it broke is in its code and detail
+func errActionInvalid(err error) RulesViolation {
+	return &Violation{Code: ViolationCodeOf(err), Detail: err.Error(), msg: "action is invalid"}
+}
+
+// violationMessage is the error's message, with the violation's detail if it has one
+func violationMessage(err error) string {
+	var v *Violation
+	if errors.As(err, &v) && v.Detail != "" {
+		return v.msg + ": " + v.Detail
+	}
+	return err.Error()
+}
+
+func violation(code ViolationCode, format string, args ...any) RulesViolation {
+	return &Violation{Code: code, msg: fmt.Sprintf(format, args...)}
+}
+
+// ViolationCodeOf returns the code of the Violation in err, or ViolationUnknown if there isn't one
+func ViolationCodeOf(err error) ViolationCode {
+	var v *Violation
+	if errors.As(err, &v) {
+		return v.Code
+	}
+	return ViolationUnknown
+}
+
+// violations with fixed messages, so checking the whole action space doesn't allocate
+var (
+	errNotYourTurn           = violation(ViolationNotYourTurn, "not your turn")
+	errGameComplete          = violation(ViolationGameComplete, "game is complete")
+	errGameNotStarted        = violation(ViolationGameNotStarted, "game has not started")
+	errGameStarted           = violation(ViolationGameStarted, "game has already started")
+	errUnknownAction         = violation(ViolationUnknownAction, "unknown action")
+	errIndexOutOfRange       = violation(ViolationIndexOutOfRange, "index out of range")
+	errNothingToScout        = violation(ViolationNothingToScout, "there is no active set to scout from")
+	errScoutNotFromEnd       = violation(ViolationScoutNotFromEnd, "can only scout from the ends of the active set")
+	errSetEmpty              = violation(ViolationSetEmpty, "set cannot be empty")
+	errSetNotConsecutive     = violation(ViolationSetNotConsecutiveOrMatching, "set is neither consecutive nor matching")
+	errSetNotMatching        = violation(ViolationSetNotConsecutiveOrMatching, "set is not matching")
+	errSetTooWeak            = violation(ViolationSetTooWeak, "set does not beat the active set")
+	errScoutAndShowUsed      = violation(ViolationScoutAndShowUsed, "scout and show has already been used this round")
+	errReverseHandNotAllowed = violation(ViolationReverseHandNotAllowed, "hand can only be reversed before the first action")
+	errGameFull              = violation(ViolationGameFull, "game is full")
+	errSeatTaken             = violation(ViolationSeatTaken, "seat is taken")
+	errSeatEmpty             = violation(ViolationSeatEmpty, "seat is empty")
+)
+
 // validateSet checks if the provided set of cards is valid according to game rules.
 func validateSet(set []*Card) RulesViolation {
 	if len(set) == 0 {
-		return RulesViolation(fmt.Errorf("set cannot be empty"))
+		return errSetEmpty
 	}
 	if len(set) == 1 {
 		return nil // single card is always valid
@@ -25,7 +110,7 @@ func validateSet(set []*Card) RulesViolation {
 	} else if math.Abs(float64(set[0].Value1-set[1].Value1)) == 1 {
 		isMatching = false
 	} else {
-		return RulesViolation(fmt.Errorf("set is neither consecutive nor matching"))
+		return errSetNotConsecutive
 	}
 
 	if isMatching {
@@ -33,7 +118,7 @@ func validateSet(set []*Card) RulesViolation {
 		firstValue := set[0].Value1
 		for _, card := range set[1:] {
 			if card.Value1 != firstValue {
-				return RulesViolation(fmt.Errorf("set is not matching"))
+				return errSetNotMatching
 			}
 		}
 	} else {
@@ -41,14 +126,14 @@ func validateSet(set []*Card) RulesViolation {
 		if set[0].Value1-set[1].Value1 < 0 { // consecutive ascending
 			for _, card := range set[1:] {
 				if lastVal-card.Value1 != -1 {
-					return RulesViolation(fmt.Errorf("set is neither consecutive nor matching"))
+					return errSetNotConsecutive
 				}
 				lastVal = card.Value1
 			}
 		} else { // consecutive descending
 			for _, card := range set[1:] {
 				if lastVal-card.Value1 != 1 {
-					return RulesViolation(fmt.Errorf("set is neither consecutive nor matching"))
+					return errSetNotConsecutive
 				}
 				lastVal = card.Value1
 			}
@@ -0,0 +1,133 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/gorilla/websocket"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// query parameters that stand in for the token headers, which browsers can't set on a WebSocket
+var WEBSOCKET_TOKEN_PARAMS = map[string]string{
+	"seat_token":  SEAT_TOKEN_HEADER,
+	"admin_token": ADMIN_TOKEN_HEADER,
+}
+
+// serveWebSocket runs a stream that takes messages from the client, such as PlaySession, over
+// a WebSocket. each text message either way is one message in protojson; if the stream fails,
+// its status is sent as {"error": ...} before the socket closes.
+func (gw *Gateway) serveWebSocket(w http.ResponseWriter, r *http.Request, sd grpc.StreamDesc, fullMethod string) {
+	upgrader := websocket.Upgrader{CheckOrigin: gw.checkOrigin}
+	conn, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		return // the upgrader has answered the request
+	}
+	defer conn.Close()
+	conn.SetReadLimit(MAX_GATEWAY_BODY)
+
+	ctx, cancel := context.WithCancel(gatewayContext(r))
+	defer cancel()
+	md, _ := metadata.FromIncomingContext(ctx)
+	md = md.Copy()
+	for param, header := range WEBSOCKET_TOKEN_PARAMS {
+		if value := r.URL.Query().Get(param); value != "" {
+			md.Set(header, value)
+		}
+	}
+	stream := &webSocketStream{ctx: metadata.NewIncomingContext(ctx, md), conn: conn, recv: make(chan []byte)}
+	go stream.readLoop(cancel, sd.ClientStreams)
+
+	info := &grpc.StreamServerInfo{FullMethod: fullMethod, IsClientStream: sd.ClientStreams, IsServerStream: sd.ServerStreams}
+	if gw.stream != nil {
+		err = gw.stream(gw.server, stream, info, sd.Handler)
+	} else {
+		err = sd.Handler(gw.server, stream)
+	}
+	if err != nil {
+		conn.WriteMessage(websocket.TextMessage, gatewayErrorMessage(err))
+	}
+	conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""))
+}
+
+// checkOrigin lets browsers open WebSockets from the gateway's own origin, and from
+// AllowedOrigins
+func (gw *Gateway) checkOrigin(r *http.Request) bool {
+	origin := r.Header.Get("Origin")
+	if origin == "" || slices.Contains(gw.AllowedOrigins, "*") || slices.Contains(gw.AllowedOrigins, origin) {
+		return true
+	}
+	u, err := url.Parse(origin)
+	return err == nil && strings.EqualFold(u.Host, r.Host)
+}
+
+// webSocketStream is a gRPC server stream over a WebSocket
+type webSocketStream struct {
+	ctx  context.Context
+	conn *websocket.Conn
+	recv chan []byte // the client's messages, from readLoop
+	err  error       // why recv was closed
+}
+
+// readLoop reads the socket until the client closes it or it fails, then cancels the stream,
+// so that a handler which no longer reads, such as WatchGame's, still learns the client has
+// gone. a stream that doesn't take messages from the client keeps its first and drops the rest.
+func (s *webSocketStream) readLoop(cancel context.CancelFunc, clientStreams bool) {
+	defer cancel()
+	defer close(s.recv)
+
+	received := false
+	for {
+		kind, data, err := s.conn.ReadMessage()
+		switch {
+		case websocket.IsCloseError(err, websocket.CloseNormalClosure, websocket.CloseGoingAway):
+			s.err = io.EOF
+			return
+		case errors.Is(err, websocket.ErrReadLimit):
+			s.err = status.Errorf(codes.ResourceExhausted, "websocket: messages are limited to %d bytes", MAX_GATEWAY_BODY)
+			return
+		case err != nil:
+			s.err = status.Errorf(codes.Unavailable, "websocket: %v", err)
+			return
+		}
+		if kind != websocket.TextMessage || (received && !clientStreams) {
+			continue
+		}
+		received = true
+		select {
+		case s.recv <- data:
+		case <-s.ctx.Done():
+			return
+		}
+	}
+}
+
+func (s *webSocketStream) SetHeader(metadata.MD) error  { return nil }
+func (s *webSocketStream) SendHeader(metadata.MD) error { return nil }
+func (s *webSocketStream) SetTrailer(metadata.MD)       {}
+func (s *webSocketStream) Context() context.Context     { return s.ctx }
+
+func (s *webSocketStream) RecvMsg(m interface{}) error {
+	data, ok := <-s.recv
+	if !ok {
+		return s.err
+	}
+	return decodeGateway(data, m)
+}
+
+func (s *webSocketStream) SendMsg(m interface{}) error {
+	data, err := gatewayMarshal.Marshal(m.(proto.Message))
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to encode message: %v", err)
+	}
+	return s.conn.WriteMessage(websocket.TextMessage, data)
+}
@@ -0,0 +1,116 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/websocket"
+	"google.golang.org/grpc/codes"
+
+	pb "scout-go/proto"
+)
+
+func TestWebSocketPlaySession(t *testing.T) {
+	s := NewScoutServer()
+	srv := httptest.NewServer(NewGateway(s, nil, nil))
+	defer srv.Close()
+	created, _ := s.CreateGame(context.Background(), &pb.CreateGameRequest{NumPlayers: 2})
+
+	dial := func(query string, header http.Header) *websocket.Conn {
+		conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(srv.URL, "http")+"/v1/PlaySession?"+query, header)
+		if err != nil {
+			t.Fatalf("failed to dial: %v", err)
+		}
+		t.Cleanup(func() { conn.Close() })
+		return conn
+	}
+	send := func(conn *websocket.Conn, message string) {
+		if err := conn.WriteMessage(websocket.TextMessage, []byte(message)); err != nil {
+			t.Fatalf("failed to send: %v", err)
+		}
+	}
+	// next returns the next message, which must have the given field
+	next := func(conn *websocket.Conn, field string) map[string]json.RawMessage {
+		_, data, err := conn.ReadMessage()
+		if err != nil {
+			t.Fatalf("expected %s, got err: %v", field, err)
+		}
+		var message map[string]json.RawMessage
+		json.Unmarshal(data, &message)
+		if _, ok := message[field]; !ok {
+			t.Fatalf("expected %s, got %s", field, data)
+		}
+		return message
+	}
+	join := `{"join": {"game_id": "` + created.GameId + `", "player_index": 0}}`
+
+	conn := dial("seat_token="+created.SeatTokens[0], nil)
+	send(conn, join)
+	next(conn, "joined")
+	next(conn, "prompt")
+	send(conn, `{"action": {"action_type": "ActionShow", "show_length": 1}}`)
+	var result struct {
+		Err           bool
+		ViolationCode string `json:"violation_code"`
+	}
+	json.Unmarshal(next(conn, "result")["result"], &result)
+	if result.Err {
+		t.Fatalf("expected the show to be accepted")
+	}
+	next(conn, "event")
+	send(conn, `{"action": {"action_type": "ActionShow", "show_length": 1}}`)
+	json.Unmarshal(next(conn, "result")["result"], &result)
+	if result.ViolationCode != "ViolationNotYourTurn" {
+		t.Fatalf("expected a rule violation, got %+v", result)
+	}
+
+	// failures end the session with an error
+	outsider := dial("seat_token=nope", nil)
+	send(outsider, `{"join": {"game_id": "`+created.GameId+`", "player_index": 1}}`)
+	var failure struct{ Code codes.Code }
+	json.Unmarshal(next(outsider, "error")["error"], &failure)
+	if failure.Code != codes.PermissionDenied {
+		t.Fatalf("expected PermissionDenied, got %v", failure.Code)
+	}
+
+	// other sites can't open sockets unless they are allowed to
+	url := "ws" + strings.TrimPrefix(srv.URL, "http") + "/v1/PlaySession"
+	if _, _, err := websocket.DefaultDialer.Dial(url, http.Header{"Origin": {"https://elsewhere.example"}}); err == nil {
+		t.Fatalf("expected a cross-origin socket to be refused")
+	}
+}
+
+func TestWebSocketWatchGameEndsWithClient(t *testing.T) {
+	s := NewScoutServer()
+	srv := httptest.NewServer(NewGateway(s, nil, nil))
+	defer srv.Close()
+	created, _ := s.CreateGame(context.Background(), &pb.CreateGameRequest{NumPlayers: 2})
+	url := "ws" + strings.TrimPrefix(srv.URL, "http") + "/v1/WatchGame"
+
+	conn, _, err := websocket.DefaultDialer.Dial(url, nil)
+	if err != nil {
+		t.Fatalf("failed to dial: %v", err)
+	}
+	conn.WriteMessage(websocket.TextMessage, []byte(`{"game_id": "`+created.GameId+`", "spectator": true}`))
+	game := s.store.Get(created.GameId)
+	waitForSubscribers(t, game, 1)
+
+	// nothing happens in the game, but the watcher still goes when its client does
+	conn.Close()
+	waitForSubscribers(t, game, 0)
+
+	// messages are no larger than request bodies may be
+	conn, _, err = websocket.DefaultDialer.Dial(url, nil)
+	if err != nil {
+		t.Fatalf("failed to dial: %v", err)
+	}
+	defer conn.Close()
+	conn.WriteMessage(websocket.TextMessage, make([]byte, MAX_GATEWAY_BODY+1))
+	if _, _, err := conn.ReadMessage(); !websocket.IsCloseError(err, websocket.CloseMessageTooBig) {
+		t.Fatalf("expected the socket to close for a message too big, got %v", err)
+	}
+}